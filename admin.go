@@ -7,14 +7,62 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
 )
 
 type AdminService service
 
+const (
+	// maxDeletePublicIds is the maximum number of public IDs of a single DeleteResources request
+	maxDeletePublicIds = 100
+	// defaultDeleteConcurrency is the number of batches DeleteResourcesBulk runs at once by default
+	defaultDeleteConcurrency = 4
+)
+
+// DeletionStatus is the outcome of the deletion of a single resource
+type DeletionStatus string
+
+const (
+	DeletionStatusDeleted  DeletionStatus = "deleted"
+	DeletionStatusNotFound DeletionStatus = "not_found"
+)
+
+// DeletedCount is the number of original and derived files deleted for a resource
+type DeletedCount struct {
+	Original int `json:"original"`
+	Derived  int `json:"derived"`
+}
+
 type AdminResponse struct {
-	Deleted interface{} `json:"deleted"`
-	Partial bool        `json:"partial"`
+	Deleted       map[string]DeletionStatus `json:"deleted"`
+	DeletedCounts map[string]DeletedCount   `json:"deleted_counts,omitempty"`
+	Partial       bool                      `json:"partial"`
+	NextCursor    string                    `json:"next_cursor"`
+}
+
+// Succeeded returns the public IDs that were deleted
+func (ar *AdminResponse) Succeeded() []string {
+	return ar.withStatus(DeletionStatusDeleted)
+}
+
+// NotFound returns the public IDs that didn't exist
+func (ar *AdminResponse) NotFound() []string {
+	return ar.withStatus(DeletionStatusNotFound)
+}
+
+// withStatus returns the sorted public IDs with the given deletion status
+func (ar *AdminResponse) withStatus(status DeletionStatus) []string {
+	ids := make([]string, 0)
+	for pId, s := range ar.Deleted {
+		if s == status {
+			ids = append(ids, pId)
+		}
+	}
+	sort.Strings(ids)
+	return ids
 }
 
 func (ar *AdminResponse) ToJSON() string {
@@ -49,10 +97,6 @@ func (as *AdminService) DeleteResources(ctx context.Context, publicIds []string,
 		params["next_cursor"] = nextCursor
 	}
 
-	for _, pId := range publicIds {
-		params["public_ids[]"] = pId
-	}
-
 	resourceType := o.GetResourceType()
 	if resourceType == "" {
 		resourceType = "image"
@@ -64,6 +108,7 @@ func (as *AdminService) DeleteResources(ctx context.Context, publicIds []string,
 
 	u := fmt.Sprintf("resources/%s/%s", resourceType, storageType)
 	u = as.buildURLStrWithParams(u, params)
+	u = as.addQueryValues(u, "public_ids[]", publicIds)
 
 	request, err := as.client.NewRequest("DELETE", u, o)
 	if err != nil {
@@ -76,6 +121,84 @@ func (as *AdminService) DeleteResources(ctx context.Context, publicIds []string,
 	return ar, resp, err
 }
 
+// DeleteResourcesBulk deletes the resources with the given publicIds, without limit on their number.
+// The ids are deleted in batches of 100 by DeleteResources, running up to WithConcurrency
+// batches at once (4 by default), and the deletion statuses of all the batches are merged.
+// The response is partial when any batch is partial, it has no NextCursor since
+// the cursor of a batch doesn't continue the whole deletion: calling it again
+// with the ids that aren't in Deleted continues it.
+// When a batch fails the remaining ones are canceled, and the statuses of
+// the batches that succeeded are returned along with the error.
+// WithNextCursor isn't supported, since it would be sent with every batch.
+func (as *AdminService) DeleteResourcesBulk(ctx context.Context, publicIds []string, opts ...SetOpts) (ar *AdminResponse, err error) {
+	if len(publicIds) == 0 {
+		return nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+	if o.GetNextCursor() != "" {
+		return nil, errors.New("next cursor isn't supported by bulk deletion")
+	}
+	concurrency := o.GetConcurrency()
+	if concurrency <= 0 {
+		concurrency = defaultDeleteConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, concurrency)
+	)
+	ar = &AdminResponse{}
+
+schedule:
+	for start := 0; start < len(publicIds); start += maxDeletePublicIds {
+		end := start + maxDeletePublicIds
+		if end > len(publicIds) {
+			end = len(publicIds)
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break schedule
+		}
+
+		wg.Add(1)
+		go func(batch []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			page, _, batchErr := as.DeleteResources(ctx, batch, opts...)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if batchErr != nil {
+				if err == nil {
+					err = batchErr
+					cancel()
+				}
+				return
+			}
+			ar.mergeStatuses(page)
+			ar.Partial = ar.Partial || page.Partial
+		}(publicIds[start:end])
+	}
+
+	wg.Wait()
+
+	if err == nil {
+		err = ctx.Err()
+	}
+	return ar, err
+}
+
 // DeleteResource deletes all resources, including derived resources,
 // where the publicId starts with the given prefix
 // (up to maximum of 1000 original resources)
@@ -124,8 +247,52 @@ func (as *AdminService) DeleteResourcesByPrefix(ctx context.Context, prefix stri
 	return ar, resp, err
 }
 
-func (as *AdminService) DeleteAllResources(opts ...SetOpts) (ar *AdminResponse, resp *Response, err error) {
-	return &AdminResponse{}, &Response{}, nil
+// DeleteAllResources deletes all resources of the given resource type and storage type,
+// up to a maximum of 1000 original resources per call. When more resources remain,
+// the response is partial and its NextCursor continues the deletion with WithNextCursor.
+// WithContinueUntilDone(true) keeps calling the API until the response isn't partial.
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#delete_resources
+func (as *AdminService) DeleteAllResources(ctx context.Context, opts ...SetOpts) (ar *AdminResponse, resp *Response, err error) {
+	o := new(Options)
+	params := make(map[string]string)
+
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params["all"] = "true"
+
+	keepOriginal := o.GetKeepOriginal()
+	if keepOriginal {
+		params["keep_original"] = strconv.FormatBool(keepOriginal)
+	}
+	invalidate := o.GetInvalidate()
+	if invalidate {
+		params["invalidate"] = strconv.FormatBool(invalidate)
+	}
+
+	u := fmt.Sprintf("resources/%s/%s", as.resourceTypeOrDefault(o), as.storageTypeOrDefault(o))
+
+	ar = &AdminResponse{}
+	nextCursor := o.GetNextCursor()
+	for {
+		if nextCursor != "" {
+			params["next_cursor"] = nextCursor
+		}
+
+		page := new(AdminResponse)
+		resp, err = as.send(ctx, "DELETE", as.buildURLStrWithParams(u, params), nil, page)
+		if err != nil {
+			return ar, resp, err
+		}
+		ar.merge(page)
+
+		if !page.Partial || !o.GetContinueUntilDone() || page.NextCursor == "" {
+			return ar, resp, nil
+		}
+		nextCursor = page.NextCursor
+	}
 }
 
 // DeleteResourcesByTag deletes all resources and their derivatives
@@ -173,12 +340,104 @@ func (as *AdminService) DeleteResourcesByTag(ctx context.Context, tag string, op
 	return ar, resp, err
 }
 
-func (as *AdminService) DeleteDerivedResources(derivedResourceIds string, opts ...SetOpts) (ar *AdminResponse, resp *Response, err error) {
-	return &AdminResponse{}, &Response{}, nil
+// DeleteDerivedResources deletes the derived resources with the given ids,
+// up to 100 ids. The ids are listed in the Derived field of GetResource.
+// WithInvalidate(true) also invalidates the CDN cached copies of the derived resources.
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#delete_derived_resources
+func (as *AdminService) DeleteDerivedResources(ctx context.Context, derivedResourceIds []string, opts ...SetOpts) (ar *AdminResponse, resp *Response, err error) {
+	if len(derivedResourceIds) == 0 {
+		return &AdminResponse{}, &Response{}, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	params := make(map[string]string)
+
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	invalidate := o.GetInvalidate()
+	if invalidate {
+		params["invalidate"] = strconv.FormatBool(invalidate)
+	}
+
+	u := as.buildURLStrWithParams("derived_resources", params)
+	u = as.addQueryValues(u, "derived_resource_ids[]", derivedResourceIds)
+
+	ar = new(AdminResponse)
+	resp, err = as.send(ctx, "DELETE", u, nil, ar)
+	return ar, resp, err
+}
+
+// DeleteDerivedResourcesByTransformation deletes the derived resources of the given
+// public IDs (up to 100 ids) that were generated with the given transformations,
+// e.g. "c_fill,w_300" or "c_crop,h_200/png". The original resources are kept.
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#delete_resources
+func (as *AdminService) DeleteDerivedResourcesByTransformation(ctx context.Context, publicIds, transformations []string, opts ...SetOpts) (ar *AdminResponse, resp *Response, err error) {
+	if len(publicIds) == 0 || len(transformations) == 0 {
+		return &AdminResponse{}, &Response{}, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	params := make(map[string]string)
+
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params["keep_original"] = "true"
+	params["transformations"] = strings.Join(transformations, "|")
+
+	invalidate := o.GetInvalidate()
+	if invalidate {
+		params["invalidate"] = strconv.FormatBool(invalidate)
+	}
+
+	u := fmt.Sprintf("resources/%s/%s", as.resourceTypeOrDefault(o), as.storageTypeOrDefault(o))
+	u = as.buildURLStrWithParams(u, params)
+	u = as.addQueryValues(u, "public_ids[]", publicIds)
+
+	ar = new(AdminResponse)
+	resp, err = as.send(ctx, "DELETE", u, nil, ar)
+	return ar, resp, err
+}
+
+// merge adds another page of the same cursor based deletion,
+// the response is partial and continues from the cursor of the page
+func (ar *AdminResponse) merge(page *AdminResponse) {
+	ar.mergeStatuses(page)
+	ar.Partial = page.Partial
+	ar.NextCursor = page.NextCursor
 }
 
-func (as *AdminService) DeleteDerivedResourcesByTransformation(publicId, transformation []string, opts ...SetOpts) (ar *AdminResponse, resp *Response, err error) {
-	return &AdminResponse{}, &Response{}, nil
+// mergeStatuses adds the deletion statuses and counts of another response
+func (ar *AdminResponse) mergeStatuses(other *AdminResponse) {
+	if ar.Deleted == nil {
+		ar.Deleted = make(map[string]DeletionStatus, len(other.Deleted))
+	}
+	for pId, status := range other.Deleted {
+		ar.Deleted[pId] = status
+	}
+	if len(other.DeletedCounts) > 0 && ar.DeletedCounts == nil {
+		ar.DeletedCounts = make(map[string]DeletedCount, len(other.DeletedCounts))
+	}
+	for pId, count := range other.DeletedCounts {
+		ar.DeletedCounts[pId] = count
+	}
+}
+
+// send sends an authenticated Admin API request with the given JSON body, if any,
+// and decodes the response into v
+func (as *AdminService) send(ctx context.Context, method, u string, body interface{}, v interface{}) (*Response, error) {
+	request, err := as.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	as.withBasicAuthentication(request)
+
+	return as.client.Do(ctx, request, v)
 }
 
 // buildURLStrWithParams is return url string that contain query string with the given parameters
@@ -194,6 +453,20 @@ func (as *AdminService) buildURLStrWithParams(u string, params map[string]string
 	return urlObject.String()
 }
 
+// addQueryValues returns the url string with a repeated query parameter for each value,
+// e.g. public_ids[]=a&public_ids[]=b
+func (as *AdminService) addQueryValues(u, key string, values []string) string {
+	urlObject, _ := url.Parse(u)
+	q := urlObject.Query()
+
+	for _, val := range values {
+		q.Add(key, val)
+	}
+
+	urlObject.RawQuery = q.Encode()
+	return urlObject.String()
+}
+
 // Cloudinary Admin API use Basic Authentication over secure HTTP.
 // API_KEY and API_SECRET are used for the authentication
 //
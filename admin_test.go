@@ -0,0 +1,112 @@
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestDeleteDerivedResources(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []SetOpts
+		want url.Values
+	}{
+		{"default", nil, url.Values{"derived_resource_ids[]": {"d1", "d2"}}},
+		{"invalidate", []SetOpts{WithInvalidate(true)}, url.Values{"derived_resource_ids[]": {"d1", "d2"}, "invalidate": {"true"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "DELETE" || r.URL.Path != "/v1_1/demo/derived_resources" {
+					t.Errorf("request = %s %s", r.Method, r.URL)
+				}
+				if got := r.URL.Query(); got.Encode() != tt.want.Encode() {
+					t.Errorf("query = %v, want %v", got, tt.want)
+				}
+				fmt.Fprint(w, `{"deleted":{"d1":"deleted","d2":"not_found"}}`)
+			})
+
+			ar, _, err := c.Admin.DeleteDerivedResources(context.Background(), []string{"d1", "d2"}, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(ar.Succeeded(), ar.NotFound()) != "[d1] [d2]" {
+				t.Errorf("Succeeded() = %v, NotFound() = %v", ar.Succeeded(), ar.NotFound())
+			}
+		})
+	}
+}
+
+func TestDeleteAllResourcesContinueUntilDone(t *testing.T) {
+	c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("next_cursor") {
+		case "":
+			fmt.Fprint(w, `{"deleted":{"a":"deleted"},"deleted_counts":{"a":{"original":1,"derived":2}},"partial":true,"next_cursor":"c1"}`)
+		case "c1":
+			fmt.Fprint(w, `{"deleted":{"b":"deleted"},"partial":false}`)
+		default:
+			t.Errorf("request = %s", r.URL)
+		}
+	})
+
+	ar, _, err := c.Admin.DeleteAllResources(context.Background(), WithContinueUntilDone(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ar.Partial || ar.NextCursor != "" || len(ar.Succeeded()) != 2 || ar.DeletedCounts["a"].Derived != 2 {
+		t.Errorf("response = %+v", ar)
+	}
+}
+
+func TestDeleteResourcesBulk(t *testing.T) {
+	c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		ids := r.URL.Query()["public_ids[]"]
+		if len(ids) > maxDeletePublicIds {
+			t.Errorf("batch of %d ids", len(ids))
+		}
+		deleted := make(map[string]DeletionStatus, len(ids))
+		for _, id := range ids {
+			deleted[id] = DeletionStatusDeleted
+			if id == "id0" {
+				deleted[id] = DeletionStatusNotFound
+			}
+		}
+		// The batch of id100 is partial, whatever the order the batches finish in
+		partial := ids[0] == "id100"
+		if !partial {
+			time.Sleep(10 * time.Millisecond)
+		}
+		b, _ := json.Marshal(AdminResponse{Deleted: deleted, Partial: partial, NextCursor: fmt.Sprint(partial)})
+		w.Write(b)
+	})
+
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = fmt.Sprint("id", i)
+	}
+	ar, err := c.Admin.DeleteResourcesBulk(context.Background(), ids, WithConcurrency(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ar.Succeeded()) != 249 || fmt.Sprint(ar.NotFound()) != "[id0]" {
+		t.Errorf("Succeeded() = %d ids, NotFound() = %v", len(ar.Succeeded()), ar.NotFound())
+	}
+	if !ar.Partial || ar.NextCursor != "" {
+		t.Errorf("Partial = %v, NextCursor = %q, want a partial response without cursor", ar.Partial, ar.NextCursor)
+	}
+}
+
+func TestDeleteResourcesBulkNextCursor(t *testing.T) {
+	c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+
+	if _, err := c.Admin.DeleteResourcesBulk(context.Background(), []string{"a", "b"}, WithNextCursor("c1")); err == nil {
+		t.Error("expected an error")
+	}
+}
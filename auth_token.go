@@ -0,0 +1,96 @@
+package cloudinary
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const defaultAuthTokenName = "__cld_token__"
+
+// authTokenUnsafePattern matches the characters that are escaped in the acl and url of a token
+var authTokenUnsafePattern = regexp.MustCompile(`[ "#%&'/:;<=>?@\[\\\]^` + "`" + `{|}~]`)
+
+// AuthToken generates Akamai style tokens for token based authentication.
+// Either Expiration or Duration, and either ACL or URL must be given.
+//
+// Documentation: https://cloudinary.com/documentation/control_access_to_media#token_based_authentication_premium_feature
+type AuthToken struct {
+	Key        string        // The hex encoded encryption key of the account
+	TokenName  string        // The name of the token, __cld_token__ is used when empty
+	StartTime  time.Time     // The token is valid from StartTime, it's left out when zero
+	Expiration time.Time     // The token is valid until Expiration
+	Duration   time.Duration // Used when Expiration is zero, counted from StartTime or now
+	ACL        []string      // The paths the token grants access to, e.g. /image/authenticated/*
+	URL        string        // The URL path the token grants access to, ignored when ACL is given
+	IP         string        // The only IP address allowed to use the token
+}
+
+// Generate returns the token as a query or cookie string, e.g.
+// __cld_token__=st=1562063190~exp=1562063490~acl=%2fimage%2f*~hmac=...
+func (t AuthToken) Generate() (string, error) {
+	key, err := hex.DecodeString(t.Key)
+	if err != nil || len(key) == 0 {
+		return "", errors.New("auth token key must be a hex encoded string")
+	}
+
+	expiration := t.Expiration
+	if expiration.IsZero() {
+		if t.Duration <= 0 {
+			return "", errors.New("must provide either expiration or duration")
+		}
+		start := t.StartTime
+		if start.IsZero() {
+			start = time.Now()
+		}
+		expiration = start.Add(t.Duration)
+	}
+
+	if len(t.ACL) == 0 && t.URL == "" {
+		return "", errors.New("must provide either acl or url")
+	}
+
+	parts := make([]string, 0, 5)
+	if t.IP != "" {
+		parts = append(parts, "ip="+t.IP)
+	}
+	if !t.StartTime.IsZero() {
+		parts = append(parts, fmt.Sprintf("st=%d", t.StartTime.Unix()))
+	}
+	parts = append(parts, fmt.Sprintf("exp=%d", expiration.Unix()))
+	if len(t.ACL) > 0 {
+		parts = append(parts, "acl="+escapeAuthTokenValue(strings.Join(t.ACL, "!")))
+	}
+
+	toSign := parts
+	if len(t.ACL) == 0 {
+		toSign = append(toSign[:len(toSign):len(toSign)], "url="+escapeAuthTokenValue(t.URL))
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strings.Join(toSign, "~")))
+	parts = append(parts, "hmac="+hex.EncodeToString(mac.Sum(nil)))
+
+	name := t.TokenName
+	if name == "" {
+		name = defaultAuthTokenName
+	}
+
+	return name + "=" + strings.Join(parts, "~"), nil
+}
+
+// escapeAuthTokenValue percent-encodes the unsafe characters with lower case hex digits
+func escapeAuthTokenValue(s string) string {
+	return authTokenUnsafePattern.ReplaceAllStringFunc(s, func(c string) string {
+		escaped := ""
+		for _, b := range []byte(c) {
+			escaped += fmt.Sprintf("%%%02x", b)
+		}
+		return escaped
+	})
+}
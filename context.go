@@ -0,0 +1,94 @@
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	ContextCommandAdd       = "add"
+	ContextCommandRemoveAll = "remove_all"
+)
+
+// contextEscaper escapes the characters that delimit the context key-value pairs
+var contextEscaper = strings.NewReplacer("=", `\=`, "|", `\|`)
+
+// ContextMap is the custom contextual metadata of an asset.
+// It's decoded from the `custom` object of the `context` field returned by Cloudinary,
+// or from the `context` object itself when it isn't nested, as in search results.
+type ContextMap map[string]string
+
+func (c *ContextMap) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		return nil
+	}
+	if custom, ok := raw["custom"].(map[string]interface{}); ok {
+		raw = custom
+	}
+
+	m := make(ContextMap, len(raw))
+	for key, val := range raw {
+		if s, ok := val.(string); ok {
+			m[key] = s
+		} else {
+			m[key] = fmt.Sprintf("%v", val)
+		}
+	}
+	*c = m
+	return nil
+}
+
+// encodeContext returns the context as `key=value` pairs separated by `|`,
+// the `=` and `|` of the keys and values are escaped
+func encodeContext(ctx map[string]string) string {
+	keys := make([]string, 0, len(ctx))
+	for key := range ctx {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, contextEscaper.Replace(key)+"="+contextEscaper.Replace(ctx[key]))
+	}
+	return strings.Join(pairs, "|")
+}
+
+type ContextResponse struct {
+	PublicIds []string `json:"public_ids"`
+}
+
+// AddContext adds the context key-value pairs to the assets with the given public IDs,
+// existing keys are overwritten
+//
+// Documentation: https://cloudinary.com/documentation/image_upload_api_reference#context_method
+func (us *UploadService) AddContext(ctx context.Context, contextMap map[string]string, publicIds []string, opts ...SetOpts) (cr *ContextResponse, resp *Response, err error) {
+	if len(contextMap) == 0 {
+		return nil, nil, errors.New("invalid context")
+	}
+	params := map[string]interface{}{
+		"command": ContextCommandAdd,
+		"context": encodeContext(contextMap),
+	}
+
+	cr = new(ContextResponse)
+	cr.PublicIds, resp, err = us.updatePublicIds(ctx, "context", params, publicIds, opts...)
+	return cr, resp, err
+}
+
+// RemoveAllContext removes all the context of the assets with the given public IDs
+func (us *UploadService) RemoveAllContext(ctx context.Context, publicIds []string, opts ...SetOpts) (cr *ContextResponse, resp *Response, err error) {
+	params := map[string]interface{}{"command": ContextCommandRemoveAll}
+
+	cr = new(ContextResponse)
+	cr.PublicIds, resp, err = us.updatePublicIds(ctx, "context", params, publicIds, opts...)
+	return cr, resp, err
+}
@@ -0,0 +1,163 @@
+package cloudinary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type Folder struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	ExternalId string `json:"external_id"`
+}
+
+type ListFoldersResponse struct {
+	Folders    []Folder `json:"folders"`
+	NextCursor string   `json:"next_cursor"`
+	TotalCount int64    `json:"total_count"`
+}
+
+type CreateFolderResponse struct {
+	Success bool   `json:"success"`
+	Path    string `json:"path"`
+	Name    string `json:"name"`
+}
+
+type RenameFolderResponse struct {
+	From Folder `json:"from"`
+	To   Folder `json:"to"`
+}
+
+type DeleteFolderResponse struct {
+	Deleted []string `json:"deleted"`
+}
+
+// ListRootFolders lists the root folders, paged with WithMaxResults and WithNextCursor
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#get_root_folders
+func (as *AdminService) ListRootFolders(ctx context.Context, opts ...SetOpts) (lf *ListFoldersResponse, resp *Response, err error) {
+	return as.listFolders(ctx, "folders", opts...)
+}
+
+// ListSubFolders lists the sub folders of the folder with the given path, e.g. products/shoes,
+// paged with WithMaxResults and WithNextCursor
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#get_subfolders
+func (as *AdminService) ListSubFolders(ctx context.Context, path string, opts ...SetOpts) (lf *ListFoldersResponse, resp *Response, err error) {
+	u, err := folderURL(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return as.listFolders(ctx, u, opts...)
+}
+
+// FoldersPager returns a Pager over the sub folders of path, or over the root folders when path is empty
+func (as *AdminService) FoldersPager(path string, opts ...SetOpts) *Pager[Folder] {
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	return NewPager(o.GetNextCursor(), func(ctx context.Context, cursor string) ([]Folder, string, error) {
+		pageOpts := append(opts[:len(opts):len(opts)], WithNextCursor(cursor))
+
+		var lf *ListFoldersResponse
+		var err error
+		if path == "" {
+			lf, _, err = as.ListRootFolders(ctx, pageOpts...)
+		} else {
+			lf, _, err = as.ListSubFolders(ctx, path, pageOpts...)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return lf.Folders, lf.NextCursor, nil
+	})
+}
+
+// CreateFolder creates an empty folder, its missing parent folders are created too
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#create_folder
+func (as *AdminService) CreateFolder(ctx context.Context, path string) (cf *CreateFolderResponse, resp *Response, err error) {
+	u, err := folderURL(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cf = new(CreateFolderResponse)
+	resp, err = as.send(ctx, "POST", u, nil, cf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return cf, resp, nil
+}
+
+// RenameFolder moves the folder fromPath and its content to toPath
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#rename_folder
+func (as *AdminService) RenameFolder(ctx context.Context, fromPath, toPath string) (rf *RenameFolderResponse, resp *Response, err error) {
+	u, err := folderURL(fromPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	toPath = strings.Trim(toPath, "/")
+	if toPath == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	rf = new(RenameFolderResponse)
+	resp, err = as.send(ctx, "PUT", u, map[string]string{"to_folder": toPath}, rf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rf, resp, nil
+}
+
+// DeleteFolder deletes an empty folder, the deletion fails when the folder contains assets
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#delete_folder
+func (as *AdminService) DeleteFolder(ctx context.Context, path string) (df *DeleteFolderResponse, resp *Response, err error) {
+	u, err := folderURL(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	df = new(DeleteFolderResponse)
+	resp, err = as.send(ctx, "DELETE", u, nil, df)
+	if err != nil {
+		return nil, resp, err
+	}
+	return df, resp, nil
+}
+
+func (as *AdminService) listFolders(ctx context.Context, u string, opts ...SetOpts) (lf *ListFoldersResponse, resp *Response, err error) {
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+	u = as.buildURLStrWithParams(u, as.listParams(o))
+
+	lf = new(ListFoldersResponse)
+	resp, err = as.send(ctx, "GET", u, nil, lf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lf, resp, nil
+}
+
+// folderURL returns the Admin API path of a folder, each part of the folder path is escaped
+func folderURL(path string) (string, error) {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return "", errors.New("invalid parameter")
+	}
+
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return fmt.Sprintf("folders/%s", strings.Join(parts, "/")), nil
+}
@@ -0,0 +1,257 @@
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// MetadataService handles communication with the structured metadata
+// related methods of the Cloudinary API
+//
+// Documentation: https://cloudinary.com/documentation/metadata_api
+type MetadataService service
+
+type MetadataFieldType string
+
+const (
+	MetadataFieldTypeString  MetadataFieldType = "string"
+	MetadataFieldTypeInteger MetadataFieldType = "integer"
+	MetadataFieldTypeDate    MetadataFieldType = "date"
+	MetadataFieldTypeEnum    MetadataFieldType = "enum"
+	MetadataFieldTypeSet     MetadataFieldType = "set"
+)
+
+// MetadataField is the definition of a structured metadata field.
+// DefaultValue must match the type of the field, e.g. a string for date fields (YYYY-MM-DD)
+// or a []string of datasource external IDs for set fields.
+type MetadataField struct {
+	ExternalId   string              `json:"external_id,omitempty"`
+	Type         MetadataFieldType   `json:"type,omitempty"`
+	Label        string              `json:"label,omitempty"`
+	Mandatory    *bool               `json:"mandatory,omitempty"` // Left unchanged by UpdateField when nil
+	DefaultValue interface{}         `json:"default_value,omitempty"`
+	Validation   *MetadataValidation `json:"validation,omitempty"`
+	Datasource   *MetadataDatasource `json:"datasource,omitempty"`
+}
+
+// MetadataValidation is a validation rule of a metadata field,
+// it's built with StrlenValidation, GreaterThanValidation, LessThanValidation and AndValidation
+type MetadataValidation struct {
+	Type   string               `json:"type"`
+	Min    *int                 `json:"min,omitempty"`
+	Max    *int                 `json:"max,omitempty"`
+	Value  interface{}          `json:"value,omitempty"`
+	Equals *bool                `json:"equals,omitempty"`
+	Rules  []MetadataValidation `json:"rules,omitempty"`
+}
+
+// StrlenValidation limits the length of string fields, a negative min or max is ignored
+func StrlenValidation(min, max int) MetadataValidation {
+	v := MetadataValidation{Type: "strlen"}
+	if min >= 0 {
+		v.Min = &min
+	}
+	if max >= 0 {
+		v.Max = &max
+	}
+	return v
+}
+
+// GreaterThanValidation requires integer or date fields to be greater than value,
+// or greater than or equal to it when equals is true
+func GreaterThanValidation(value interface{}, equals bool) MetadataValidation {
+	return MetadataValidation{Type: "greater_than", Value: value, Equals: &equals}
+}
+
+// LessThanValidation requires integer or date fields to be less than value,
+// or less than or equal to it when equals is true
+func LessThanValidation(value interface{}, equals bool) MetadataValidation {
+	return MetadataValidation{Type: "less_than", Value: value, Equals: &equals}
+}
+
+// AndValidation requires all the rules to pass
+func AndValidation(rules ...MetadataValidation) MetadataValidation {
+	return MetadataValidation{Type: "and", Rules: rules}
+}
+
+// MetadataDatasource is the list of allowed values of enum and set fields
+type MetadataDatasource struct {
+	Values []DatasourceEntry `json:"values"`
+}
+
+type DatasourceEntry struct {
+	ExternalId string `json:"external_id,omitempty"`
+	Value      string `json:"value"`
+	State      string `json:"state,omitempty"` // active or inactive, read only
+}
+
+type ListMetadataFieldsResponse struct {
+	MetadataFields []MetadataField `json:"metadata_fields"`
+}
+
+type MetadataMessageResponse struct {
+	Message string `json:"message"`
+}
+
+type UpdateMetadataResponse struct {
+	PublicIds []string `json:"public_ids"`
+}
+
+// ListFields returns the definitions of all the metadata fields
+func (ms *MetadataService) ListFields(ctx context.Context) (lf *ListMetadataFieldsResponse, resp *Response, err error) {
+	lf = new(ListMetadataFieldsResponse)
+	resp, err = ms.do(ctx, "GET", "metadata_fields", nil, lf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lf, resp, nil
+}
+
+// GetField returns the definition of the metadata field with the given external ID
+func (ms *MetadataService) GetField(ctx context.Context, externalId string) (mf *MetadataField, resp *Response, err error) {
+	if externalId == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	mf = new(MetadataField)
+	resp, err = ms.do(ctx, "GET", fieldPath(externalId), nil, mf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mf, resp, nil
+}
+
+// CreateField creates a metadata field, the external ID is generated when it's empty
+func (ms *MetadataService) CreateField(ctx context.Context, field MetadataField) (mf *MetadataField, resp *Response, err error) {
+	if field.Type == "" || field.Label == "" {
+		return nil, nil, errors.New("type and label are required")
+	}
+
+	mf = new(MetadataField)
+	resp, err = ms.do(ctx, "POST", "metadata_fields", field, mf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mf, resp, nil
+}
+
+// UpdateField updates the label, mandatory, default value and validation of a metadata field,
+// its type can't be changed. Only the attributes set in field are sent, the others are left unchanged.
+func (ms *MetadataService) UpdateField(ctx context.Context, externalId string, field MetadataField) (mf *MetadataField, resp *Response, err error) {
+	if externalId == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	mf = new(MetadataField)
+	resp, err = ms.do(ctx, "PUT", fieldPath(externalId), field, mf)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mf, resp, nil
+}
+
+// DeleteField deletes the metadata field with the given external ID
+func (ms *MetadataService) DeleteField(ctx context.Context, externalId string) (mm *MetadataMessageResponse, resp *Response, err error) {
+	if externalId == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	mm = new(MetadataMessageResponse)
+	resp, err = ms.do(ctx, "DELETE", fieldPath(externalId), nil, mm)
+	if err != nil {
+		return nil, resp, err
+	}
+	return mm, resp, nil
+}
+
+// UpdateDatasource adds or updates the given datasource entries of an enum or set field,
+// entries are matched by external ID and the ones without external ID are added
+func (ms *MetadataService) UpdateDatasource(ctx context.Context, externalId string, entries []DatasourceEntry) (md *MetadataDatasource, resp *Response, err error) {
+	if externalId == "" || len(entries) == 0 {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	md = new(MetadataDatasource)
+	body := MetadataDatasource{Values: entries}
+	resp, err = ms.do(ctx, "PUT", fieldPath(externalId)+"/datasource", body, md)
+	if err != nil {
+		return nil, resp, err
+	}
+	return md, resp, nil
+}
+
+// DeleteDatasourceEntries marks the datasource entries with the given external IDs as inactive
+func (ms *MetadataService) DeleteDatasourceEntries(ctx context.Context, externalId string, entryIds []string) (md *MetadataDatasource, resp *Response, err error) {
+	return ms.updateDatasourceEntries(ctx, "DELETE", fieldPath(externalId)+"/datasource", externalId, entryIds)
+}
+
+// RestoreDatasourceEntries marks the inactive datasource entries with the given external IDs as active
+func (ms *MetadataService) RestoreDatasourceEntries(ctx context.Context, externalId string, entryIds []string) (md *MetadataDatasource, resp *Response, err error) {
+	return ms.updateDatasourceEntries(ctx, "POST", fieldPath(externalId)+"/datasource_restore", externalId, entryIds)
+}
+
+func (ms *MetadataService) updateDatasourceEntries(ctx context.Context, method, u, externalId string, entryIds []string) (md *MetadataDatasource, resp *Response, err error) {
+	if externalId == "" || len(entryIds) == 0 {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	md = new(MetadataDatasource)
+	body := map[string][]string{"external_ids": entryIds}
+	resp, err = ms.do(ctx, method, u, body, md)
+	if err != nil {
+		return nil, resp, err
+	}
+	return md, resp, nil
+}
+
+// UpdateMetadata sets the metadata values, keyed by field external ID, of the assets with the given public IDs
+//
+// Documentation: https://cloudinary.com/documentation/image_upload_api_reference#metadata_method
+func (ms *MetadataService) UpdateMetadata(ctx context.Context, metadata map[string]interface{}, publicIds []string, opts ...SetOpts) (um *UpdateMetadataResponse, resp *Response, err error) {
+	if len(metadata) == 0 {
+		return nil, nil, errors.New("invalid metadata")
+	}
+	params := map[string]interface{}{"metadata": encodeMetadata(metadata)}
+
+	um = new(UpdateMetadataResponse)
+	um.PublicIds, resp, err = (*UploadService)(ms).updatePublicIds(ctx, "metadata", params, publicIds, opts...)
+	return um, resp, err
+}
+
+// do sends an Admin API request with a JSON body and decodes the response into v
+func (ms *MetadataService) do(ctx context.Context, method, u string, body interface{}, v interface{}) (*Response, error) {
+	return (*AdminService)(ms).send(ctx, method, u, body, v)
+}
+
+func fieldPath(externalId string) string {
+	return fmt.Sprintf("metadata_fields/%s", url.PathEscape(externalId))
+}
+
+// encodeMetadata returns the metadata as `external_id=value` pairs separated by `|`.
+// Lists are JSON encoded, the `=` and `|` of the keys and values are escaped.
+func encodeMetadata(metadata map[string]interface{}) string {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		var value string
+		switch val := metadata[key].(type) {
+		case []string, []interface{}:
+			b, _ := json.Marshal(val)
+			value = contextEscaper.Replace(string(b))
+		default:
+			value = contextEscaper.Replace(fmt.Sprintf("%v", val))
+		}
+		pairs = append(pairs, contextEscaper.Replace(key)+"="+value)
+	}
+	return strings.Join(pairs, "|")
+}
@@ -0,0 +1,108 @@
+package cloudinary
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestUpdateFieldPartial(t *testing.T) {
+	mandatory := false
+	tests := []struct {
+		name  string
+		field MetadataField
+		want  string
+	}{
+		{"default value only", MetadataField{DefaultValue: "draft"}, `{"default_value":"draft"}`},
+		{"label only", MetadataField{Label: "Status"}, `{"label":"Status"}`},
+		{"not mandatory", MetadataField{Mandatory: &mandatory}, `{"mandatory":false}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != "PUT" || r.URL.Path != "/v1_1/demo/metadata_fields/status" {
+					t.Errorf("request = %s %s", r.Method, r.URL)
+				}
+				if got := strings.TrimSpace(string(body)); got != tt.want {
+					t.Errorf("body = %s, want %s", got, tt.want)
+				}
+				fmt.Fprint(w, `{"external_id":"status","type":"string","label":"Status","mandatory":true}`)
+			})
+
+			mf, _, err := c.Metadata.UpdateField(context.Background(), "status", tt.field)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if mf.Mandatory == nil || !*mf.Mandatory {
+				t.Errorf("Mandatory = %v, want true", mf.Mandatory)
+			}
+		})
+	}
+}
+
+func TestDatasourceEntries(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(c *Client) (*MetadataDatasource, *Response, error)
+		wantMethod string
+		wantPath   string
+	}{
+		{
+			"delete",
+			func(c *Client) (*MetadataDatasource, *Response, error) {
+				return c.Metadata.DeleteDatasourceEntries(context.Background(), "color", []string{"red", "blue"})
+			},
+			"DELETE", "/v1_1/demo/metadata_fields/color/datasource",
+		},
+		{
+			"restore",
+			func(c *Client) (*MetadataDatasource, *Response, error) {
+				return c.Metadata.RestoreDatasourceEntries(context.Background(), "color", []string{"red", "blue"})
+			},
+			"POST", "/v1_1/demo/metadata_fields/color/datasource_restore",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != tt.wantMethod || r.URL.Path != tt.wantPath {
+					t.Errorf("request = %s %s, want %s %s", r.Method, r.URL.Path, tt.wantMethod, tt.wantPath)
+				}
+				if got := strings.TrimSpace(string(body)); got != `{"external_ids":["red","blue"]}` {
+					t.Errorf("body = %s", got)
+				}
+				fmt.Fprint(w, `{"values":[{"external_id":"red","value":"Red"}]}`)
+			})
+
+			if _, _, err := tt.call(c); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestEncodeMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata map[string]interface{}
+		want     string
+	}{
+		{"scalars", map[string]interface{}{"b": 10, "a": "x"}, "a=x|b=10"},
+		{"escaped scalar", map[string]interface{}{"a": "x=1|y"}, `a=x\=1\|y`},
+		{"list", map[string]interface{}{"colors": []string{"red", "blue"}}, `colors=["red","blue"]`},
+		{"escaped list", map[string]interface{}{"colors": []string{"a|b", "c=d"}}, `colors=["a\|b","c\=d"]`},
+		{"interface list", map[string]interface{}{"colors": []interface{}{"x|y"}}, `colors=["x\|y"]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := encodeMetadata(tt.metadata); got != tt.want {
+				t.Errorf("encodeMetadata() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package cloudinary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// RawTransformation is a transformation given in the Cloudinary syntax, e.g. c_fill,w_300/e_sepia
+type RawTransformation string
+
+func (r RawTransformation) String() string {
+	return string(r)
+}
+
+type NamedTransformation struct {
+	Name             string `json:"name"`
+	AllowedForStrict bool   `json:"allowed_for_strict"`
+	Used             bool   `json:"used"`
+	Named            bool   `json:"named"`
+}
+
+// NamedTransformationDetails is a transformation with the derived resources that use it
+type NamedTransformationDetails struct {
+	NamedTransformation
+
+	Info       []map[string]interface{} `json:"info"`
+	Derived    []TransformationUsage    `json:"derived"`
+	NextCursor string                   `json:"next_cursor"`
+}
+
+// TransformationUsage is a derived resource generated with a transformation
+type TransformationUsage struct {
+	Id           string `json:"id"`
+	PublicId     string `json:"public_id"`
+	ResourceType string `json:"resource_type"`
+	Type         string `json:"type"`
+	Format       string `json:"format"`
+	Bytes        int64  `json:"bytes"`
+	URL          string `json:"url"`
+	SecureURL    string `json:"secure_url"`
+}
+
+type ListTransformationsResponse struct {
+	Transformations []NamedTransformation `json:"transformations"`
+	NextCursor      string                `json:"next_cursor"`
+}
+
+type TransformationMessageResponse struct {
+	Message string `json:"message"`
+}
+
+// ListTransformations lists the transformations, WithNamed(true) only lists the named ones
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#transformations
+func (as *AdminService) ListTransformations(ctx context.Context, opts ...SetOpts) (lt *ListTransformationsResponse, resp *Response, err error) {
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params := as.listParams(o)
+	if o.Named != nil {
+		params["named"] = strconv.FormatBool(*o.Named)
+	}
+	u := as.buildURLStrWithParams("transformations", params)
+
+	lt = new(ListTransformationsResponse)
+	resp, err = as.send(ctx, "GET", u, nil, lt)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lt, resp, nil
+}
+
+// GetTransformation returns the named transformation with the derived resources that use it,
+// paged with WithMaxResults and WithNextCursor
+func (as *AdminService) GetTransformation(ctx context.Context, name string, opts ...SetOpts) (td *NamedTransformationDetails, resp *Response, err error) {
+	if name == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params := as.listParams(o)
+	params["transformation"] = name
+	u := as.buildURLStrWithParams("transformations", params)
+
+	td = new(NamedTransformationDetails)
+	resp, err = as.send(ctx, "GET", u, nil, td)
+	if err != nil {
+		return nil, resp, err
+	}
+	return td, resp, nil
+}
+
+// CreateTransformation creates a named transformation, the definition is either
+// a Transformation or a RawTransformation, e.g. RawTransformation("c_fill,w_150,h_100")
+func (as *AdminService) CreateTransformation(ctx context.Context, name string, definition fmt.Stringer) (tm *TransformationMessageResponse, resp *Response, err error) {
+	if name == "" || definition == nil {
+		return nil, nil, errors.New("invalid parameter")
+	}
+	t := transformationDefinition(definition)
+	if t == "" {
+		return nil, nil, errors.New("invalid transformation")
+	}
+
+	params := map[string]string{
+		"name":           name,
+		"transformation": t,
+	}
+	u := as.buildURLStrWithParams("transformations", params)
+
+	tm = new(TransformationMessageResponse)
+	resp, err = as.send(ctx, "POST", u, nil, tm)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tm, resp, nil
+}
+
+// UpdateTransformation updates a named transformation with WithAllowedForStrict and WithUnsafeUpdate
+func (as *AdminService) UpdateTransformation(ctx context.Context, name string, opts ...SetOpts) (tm *TransformationMessageResponse, resp *Response, err error) {
+	if name == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params := map[string]string{"transformation": name}
+	if o.AllowedForStrict != nil {
+		params["allowed_for_strict"] = strconv.FormatBool(*o.AllowedForStrict)
+	}
+	if o.UnsafeUpdate != nil {
+		params["unsafe_update"] = *o.UnsafeUpdate
+	}
+	u := as.buildURLStrWithParams("transformations", params)
+
+	tm = new(TransformationMessageResponse)
+	resp, err = as.send(ctx, "PUT", u, nil, tm)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tm, resp, nil
+}
+
+// DeleteTransformation deletes a named transformation and its derived resources
+func (as *AdminService) DeleteTransformation(ctx context.Context, name string) (tm *TransformationMessageResponse, resp *Response, err error) {
+	if name == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	u := as.buildURLStrWithParams("transformations", map[string]string{"transformation": name})
+
+	tm = new(TransformationMessageResponse)
+	resp, err = as.send(ctx, "DELETE", u, nil, tm)
+	if err != nil {
+		return nil, resp, err
+	}
+	return tm, resp, nil
+}
+
+// transformationDefinition returns the transformation string of a definition,
+// the format of a Transformation is kept as its extension like for eager transformations
+func transformationDefinition(definition fmt.Stringer) string {
+	if t, ok := definition.(Transformation); ok {
+		return t.eagerString()
+	}
+	return definition.String()
+}
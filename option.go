@@ -2,28 +2,50 @@ package cloudinary
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 )
 
 type ResourceType string
 
+const (
+	ResourceTypeImage ResourceType = "image"
+	ResourceTypeVideo ResourceType = "video"
+	ResourceTypeRaw   ResourceType = "raw"
+	// ResourceTypeAuto lets Cloudinary detect the resource type of an upload,
+	// it's only valid for uploading
+	ResourceTypeAuto ResourceType = "auto"
+)
+
 type Options struct {
-	//AccessControl           interface{} `json:"access_control,omitempty"`
-	AccessMode     *string  `json:"access_mode,omitempty"`
-	AllowedFormats *string  `json:"allowed_formats,omitempty"`
-	Async          *bool    `json:"async,omitempty"`
-	AutoTagging    *float64 `json:"auto_tagging,omitempty"`
+	AccessControl         *string  `json:"access_control,omitempty"` // JSON encoded list of AccessControlRule
+	AccessibilityAnalysis *bool    `json:"accessibility_analysis,omitempty"`
+	AccessMode            *string  `json:"access_mode,omitempty"`
+	AllowedForStrict      *bool    `json:"-"` // Allow a named transformation when strict transformations are enabled
+	AllowedFormats        *string  `json:"allowed_formats,omitempty"`
+	AssetFolder           *string  `json:"asset_folder,omitempty"` // Folder of the asset in dynamic folder mode
+	Async                 *bool    `json:"async,omitempty"`
+	AutoTagging           *float64 `json:"auto_tagging,omitempty"`
 
 	BackgroundRemoval *string `json:"background_removal,omitempty"`
 	Backup            *bool   `json:"backup,omitempty"`
 
 	Callback          *string `json:"callback,omitempty"`
 	Categorization    *string `json:"categorization,omitempty"`
+	ChunkSize         *int64  `json:"-"` // Size of each chunk sent by UploadLarge
 	Colors            *bool   `json:"colors,omitempty"`
+	ContextFlag       *bool   `json:"-"` // Return the context of the listed resources
+	Concurrency       *int    `json:"-"` // Number of batches DeleteResourcesBulk runs at once
 	Context           *string `json:"context,omitempty"`
+	ContinueUntilDone *bool   `json:"-"` // Repeat partial Admin API deletions until they're done
+	Coordinates       *bool   `json:"-"` // Return the face and custom coordinates of a resource
 	CustomCoordinates *string `json:"custom_coordinates,omitempty"`
 
 	Detection               *string `json:"detection,omitempty"`
+	Direction               *string `json:"-"` // Sort direction of Admin API listings
 	DiscardOriginalFilename *bool   `json:"discard_original_filename,omitempty"`
+	DisplayName             *string `json:"display_name,omitempty"` // Name of the asset in dynamic folder mode
 
 	Eager                *string `json:"eager,omitempty"`
 	EagerAsync           *bool   `json:"eager_async,omitempty"`
@@ -42,17 +64,24 @@ type Options struct {
 
 	KeepOriginal *bool `json:"keep_original,omitempty"`
 
-	Moderation *string `json:"moderation,omitempty"`
+	MaxResults       *int    `json:"-"` // Page size of Admin API listings
+	Metadata         *string `json:"metadata,omitempty"`
+	Moderation       *string `json:"moderation,omitempty"`
+	ModerationStatus *string `json:"-"` // Manual moderation result set by UpdateResource
+	ModerationsFlag  *bool   `json:"-"` // Return the moderation of the listed resources
 
+	Named           *bool   `json:"-"` // Only list named or unnamed transformations
 	NextCursor      *string `json:"next_cursor,omitempty"`
 	NotificationURL *string `json:"notification_url,omitempty"`
 
 	OCR       *string `json:"ocr,omitempty"`
 	Overwrite *bool   `json:"overwrite,omitempty"`
 
-	Phash    *bool   `json:"phash,omitempty"`
-	Proxy    *string `json:"proxy,omitempty"`
-	PublicId *string `json:"public_id,omitempty"`
+	Pages          *bool   `json:"-"` // Return the number of pages of a resource
+	Phash          *bool   `json:"phash,omitempty"`
+	Proxy          *string `json:"proxy,omitempty"`
+	PublicId       *string `json:"public_id,omitempty"`
+	PublicIdPrefix *string `json:"public_id_prefix,omitempty"` // Prefix of the generated public ID in dynamic folder mode
 
 	QualityAnalysis *bool `json:"quality_analysis,omitempty"`
 
@@ -61,22 +90,46 @@ type Options struct {
 	ResourceType      *string `json:"resource_type,omitempty"`
 	//ResponsiveBreakpoints interface{} `json:"responsive_breakpoints,omitempty"`
 
+	StartAt *string `json:"-"` // Only list resources created since this date
+
 	Tags            *string `json:"tags,omitempty"`
+	TagsFlag        *bool   `json:"-"` // Return the tags of the listed resources
 	Timestamp       *string `json:"timestamp,omitempty"`
+	ToType          *string `json:"to_type,omitempty"` // New storage type of a renamed resource
 	Transformations *string `json:"transformation,omitempty"`
 	Type            *string `json:"type,omitempty"`
 
-	UniqueFilename *bool `json:"unique_filename,omitempty"`
+	UniqueFilename *bool   `json:"unique_filename,omitempty"`
+	UnsafeUpdate   *string `json:"-"` // New definition of a named transformation that is already in use
+	// UniqueUploadId and UploadOffset are used by UploadLarge to resume
+	// a partially uploaded session, they're not upload parameters
+	UniqueUploadId *string `json:"-"`
+	UploadOffset   *int64  `json:"-"`
 	// Upload Preset is required for unsigned uploading and
 	// optional for signed uploading
 	UploadPreset *string `json:"upload_preset,omitempty"`
 	UseFilename  *bool   `json:"use_filename,omitempty"`
 
+	Versions *bool `json:"-"` // Return the backed up versions of a resource
+
 	isUnsignedUpload bool
+	// extraParams are the parameters of Upload API methods that aren't options,
+	// the values are either string or []string
+	extraParams map[string]interface{}
 }
 
 type SetOpts func(opts *Options)
 
+// AccessControlRule restricts the access to an asset, the asset is
+// accessible by anyone between Start and End when AccessType is anonymous
+//
+// Documentation: https://cloudinary.com/documentation/control_access_to_media#access_controlled_media_assets
+type AccessControlRule struct {
+	AccessType string     `json:"access_type"` // token or anonymous
+	Start      *time.Time `json:"start,omitempty"`
+	End        *time.Time `json:"end,omitempty"`
+}
+
 func WithUploadPreset(uploadPreset string) SetOpts {
 	return func(o *Options) {
 		o.UploadPreset = &uploadPreset
@@ -97,6 +150,28 @@ func WithFolder(folder string) SetOpts {
 	}
 }
 
+// WithAssetFolder sets the folder of the asset in dynamic folder mode,
+// the public ID doesn't change with the asset folder
+func WithAssetFolder(folder string) SetOpts {
+	return func(o *Options) {
+		o.AssetFolder = &folder
+	}
+}
+
+// WithDisplayName sets the name of the asset shown in the Media Library in dynamic folder mode
+func WithDisplayName(name string) SetOpts {
+	return func(o *Options) {
+		o.DisplayName = &name
+	}
+}
+
+// WithPublicIdPrefix prepends prefix to the public ID generated in dynamic folder mode
+func WithPublicIdPrefix(prefix string) SetOpts {
+	return func(o *Options) {
+		o.PublicIdPrefix = &prefix
+	}
+}
+
 func WithUseFilename(isUseFilename bool) SetOpts {
 	return func(o *Options) {
 		o.UseFilename = &isUseFilename
@@ -115,6 +190,21 @@ func WithType(typeStr string) SetOpts {
 	}
 }
 
+// WithReturnDeleteToken returns a DeleteToken in the UploadResponse,
+// the token deletes the uploaded asset with DeleteByToken within 10 minutes
+func WithReturnDeleteToken(returnDeleteToken bool) SetOpts {
+	return func(o *Options) {
+		o.ReturnDeleteToken = &returnDeleteToken
+	}
+}
+
+// WithToType changes the storage type of a resource renamed with Rename
+func WithToType(toType string) SetOpts {
+	return func(o *Options) {
+		o.ToType = &toType
+	}
+}
+
 func WithAccessMode(accessMode string) SetOpts {
 	return func(o *Options) {
 		o.AccessMode = &accessMode
@@ -145,6 +235,28 @@ func WithContext(ctx string) SetOpts {
 	}
 }
 
+// WithMetadata sets the structured metadata values, keyed by the external ID of their field.
+// Values of set fields are given as []string.
+func WithMetadata(metadata map[string]interface{}) SetOpts {
+	return func(o *Options) {
+		if len(metadata) > 0 {
+			encoded := encodeMetadata(metadata)
+			o.Metadata = &encoded
+		}
+	}
+}
+
+// WithContextMap sets the context from key-value pairs,
+// the `=` and `|` characters of the keys and values are escaped
+func WithContextMap(ctx map[string]string) SetOpts {
+	return func(o *Options) {
+		if len(ctx) > 0 {
+			encoded := encodeContext(ctx)
+			o.Context = &encoded
+		}
+	}
+}
+
 func WithColors(hasColor bool) SetOpts {
 	return func(o *Options) {
 		o.Colors = &hasColor
@@ -211,6 +323,194 @@ func WithKeepOriginal(ko bool) SetOpts {
 	}
 }
 
+// WithEager sets the transformations that are generated on upload instead of lazily on first access
+func WithEager(transformations ...Transformation) SetOpts {
+	return func(o *Options) {
+		eager := make([]string, 0, len(transformations))
+		for _, t := range transformations {
+			if !t.IsEmpty() {
+				eager = append(eager, t.eagerString())
+			}
+		}
+		if len(eager) > 0 {
+			joined := strings.Join(eager, "|")
+			o.Eager = &joined
+		}
+	}
+}
+
+// WithTransformation sets the incoming transformation that is applied to the asset before it's stored.
+// The format of the transformation, if any, is used as the format of the stored asset.
+func WithTransformation(t Transformation) SetOpts {
+	return func(o *Options) {
+		if s := t.String(); s != "" {
+			o.Transformations = &s
+		}
+		if t.format != "" {
+			o.Format = &t.format
+		}
+	}
+}
+
+func WithAccessControl(rules ...AccessControlRule) SetOpts {
+	return func(o *Options) {
+		b, err := json.Marshal(rules)
+		if err == nil {
+			ac := string(b)
+			o.AccessControl = &ac
+		}
+	}
+}
+
+// WithModerationStatus sets the manual moderation status of a resource, approved or rejected
+func WithModerationStatus(status string) SetOpts {
+	return func(o *Options) {
+		o.ModerationStatus = &status
+	}
+}
+
+func WithBackgroundRemoval(b string) SetOpts {
+	return func(o *Options) {
+		o.BackgroundRemoval = &b
+	}
+}
+
+func WithModeration(m string) SetOpts {
+	return func(o *Options) {
+		o.Moderation = &m
+	}
+}
+
+func WithInvalidate(invalidate bool) SetOpts {
+	return func(o *Options) {
+		o.Invalidate = &invalidate
+	}
+}
+
+func WithAccessibilityAnalysis(returnAccessibilityAnalysis bool) SetOpts {
+	return func(o *Options) {
+		o.AccessibilityAnalysis = &returnAccessibilityAnalysis
+	}
+}
+
+func WithCoordinates(returnCoordinates bool) SetOpts {
+	return func(o *Options) {
+		o.Coordinates = &returnCoordinates
+	}
+}
+
+func WithPages(returnPages bool) SetOpts {
+	return func(o *Options) {
+		o.Pages = &returnPages
+	}
+}
+
+func WithVersions(returnVersions bool) SetOpts {
+	return func(o *Options) {
+		o.Versions = &returnVersions
+	}
+}
+
+func WithNextCursor(cursor string) SetOpts {
+	return func(o *Options) {
+		o.NextCursor = &cursor
+	}
+}
+
+// WithMaxResults sets the maximum number of results of an Admin API listing, up to 500
+func WithMaxResults(max int) SetOpts {
+	return func(o *Options) {
+		o.MaxResults = &max
+	}
+}
+
+// WithDirection sets the sort direction of an Admin API listing, asc or desc
+func WithDirection(direction string) SetOpts {
+	return func(o *Options) {
+		o.Direction = &direction
+	}
+}
+
+// WithStartAt only lists the resources created since the given date
+func WithStartAt(startAt time.Time) SetOpts {
+	return func(o *Options) {
+		s := startAt.UTC().Format(time.RFC3339)
+		o.StartAt = &s
+	}
+}
+
+func WithAllowedForStrict(allowed bool) SetOpts {
+	return func(o *Options) {
+		o.AllowedForStrict = &allowed
+	}
+}
+
+func WithNamed(named bool) SetOpts {
+	return func(o *Options) {
+		o.Named = &named
+	}
+}
+
+// WithUnsafeUpdate replaces the definition of a named transformation,
+// the derived resources that use it are not regenerated
+func WithUnsafeUpdate(definition fmt.Stringer) SetOpts {
+	return func(o *Options) {
+		s := transformationDefinition(definition)
+		o.UnsafeUpdate = &s
+	}
+}
+
+func WithContinueUntilDone(continueUntilDone bool) SetOpts {
+	return func(o *Options) {
+		o.ContinueUntilDone = &continueUntilDone
+	}
+}
+
+// WithTagsFlag sets whether the resource listings return the tags of the resources, the default is true
+func WithTagsFlag(tags bool) SetOpts {
+	return func(o *Options) {
+		o.TagsFlag = &tags
+	}
+}
+
+// WithContextFlag sets whether the resource listings return the context of the resources, the default is true
+func WithContextFlag(context bool) SetOpts {
+	return func(o *Options) {
+		o.ContextFlag = &context
+	}
+}
+
+// WithModerationsFlag sets whether the resource listings return the moderation of the resources
+func WithModerationsFlag(moderations bool) SetOpts {
+	return func(o *Options) {
+		o.ModerationsFlag = &moderations
+	}
+}
+
+func WithConcurrency(concurrency int) SetOpts {
+	return func(o *Options) {
+		o.Concurrency = &concurrency
+	}
+}
+
+func WithChunkSize(size int64) SetOpts {
+	return func(o *Options) {
+		o.ChunkSize = &size
+	}
+}
+
+func WithUniqueUploadId(id string) SetOpts {
+	return func(o *Options) {
+		o.UniqueUploadId = &id
+	}
+}
+
+func WithUploadOffset(offset int64) SetOpts {
+	return func(o *Options) {
+		o.UploadOffset = &offset
+	}
+}
+
 func (o *Options) GetPublicId() string {
 	if o.PublicId != nil {
 		return *o.PublicId
@@ -260,6 +560,41 @@ func (o *Options) GetInvalidate() bool {
 	return false
 }
 
+func (o *Options) GetContinueUntilDone() bool {
+	if o.ContinueUntilDone != nil {
+		return *o.ContinueUntilDone
+	}
+	return false
+}
+
+func (o *Options) GetTagsFlag() bool {
+	if o.TagsFlag != nil {
+		return *o.TagsFlag
+	}
+	return true
+}
+
+func (o *Options) GetContextFlag() bool {
+	if o.ContextFlag != nil {
+		return *o.ContextFlag
+	}
+	return true
+}
+
+func (o *Options) GetModerationsFlag() bool {
+	if o.ModerationsFlag != nil {
+		return *o.ModerationsFlag
+	}
+	return false
+}
+
+func (o *Options) GetConcurrency() int {
+	if o.Concurrency != nil {
+		return *o.Concurrency
+	}
+	return 0
+}
+
 func (o *Options) GetNextCursor() string {
 	if o.NextCursor != nil {
 		return *o.NextCursor
@@ -267,12 +602,93 @@ func (o *Options) GetNextCursor() string {
 	return ""
 }
 
+func (o *Options) GetChunkSize() int64 {
+	if o.ChunkSize != nil {
+		return *o.ChunkSize
+	}
+	return 0
+}
+
+func (o *Options) GetUniqueUploadId() string {
+	if o.UniqueUploadId != nil {
+		return *o.UniqueUploadId
+	}
+	return ""
+}
+
+func (o *Options) GetUploadOffset() int64 {
+	if o.UploadOffset != nil {
+		return *o.UploadOffset
+	}
+	return 0
+}
+
+func (o *Options) GetMaxResults() int {
+	if o.MaxResults != nil {
+		return *o.MaxResults
+	}
+	return 0
+}
+
+func (o *Options) GetDirection() string {
+	if o.Direction != nil {
+		return *o.Direction
+	}
+	return ""
+}
+
+func (o *Options) GetStartAt() string {
+	if o.StartAt != nil {
+		return *o.StartAt
+	}
+	return ""
+}
+
 func WithResourceType(resourceType string) SetOpts {
 	return func(opts *Options) {
 		opts.ResourceType = &resourceType
 	}
 }
 
+// uploadEndpoint returns the upload URL path derived from the resource type
+func (o *Options) uploadEndpoint() string {
+	return fmt.Sprintf("%s/upload", o.uploadResourceType())
+}
+
+// uploadResourceType returns the resource type of Upload API calls, image by default
+func (o *Options) uploadResourceType() string {
+	if resourceType := o.GetResourceType(); resourceType != "" {
+		return resourceType
+	}
+	return string(ResourceTypeImage)
+}
+
+func (o *Options) GetModerationStatus() string {
+	if o.ModerationStatus != nil {
+		return *o.ModerationStatus
+	}
+	return ""
+}
+
+// setExtraParam sets a parameter that isn't an option, it's sent and signed with the options
+func (o *Options) setExtraParam(field string, val interface{}) {
+	if o.extraParams == nil {
+		o.extraParams = make(map[string]interface{})
+	}
+	o.extraParams[field] = val
+}
+
+// toMap returns the parameters set in the options, keyed by their API name
+func (o *Options) toMap() (map[string]interface{}, error) {
+	var optMap map[string]interface{}
+	optByte, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(optByte, &optMap)
+	return optMap, err
+}
+
 func (o *Options) toJSON() string {
 	b, _ := json.Marshal(o)
 	return string(b)
@@ -0,0 +1,79 @@
+package cloudinary
+
+import "context"
+
+// PageFunc fetches the page that starts at cursor, an empty cursor being the first page.
+// It returns the items of the page and the cursor of the next one, empty when it's the last page.
+type PageFunc[T any] func(ctx context.Context, cursor string) (items []T, nextCursor string, err error)
+
+// Pager iterates over the items of a cursor-based Admin API endpoint,
+// following `next_cursor` transparently:
+//
+//	pager := NewPager("", func(ctx context.Context, cursor string) ([]string, string, error) {
+//		// call the endpoint with WithNextCursor(cursor)
+//	})
+//	for pager.Next(ctx) {
+//		item := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		// handle error
+//	}
+type Pager[T any] struct {
+	fetch PageFunc[T]
+
+	items  []T
+	index  int
+	cursor string
+	done   bool
+	err    error
+}
+
+// NewPager returns a Pager that fetches its pages with fetch,
+// starting from the given cursor, empty for the first page
+func NewPager[T any](cursor string, fetch PageFunc[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch, cursor: cursor, index: -1}
+}
+
+// Next advances to the next item, fetching the next page when needed.
+// It returns false when there are no more items or an error occurred.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.err != nil {
+		return false
+	}
+
+	p.index++
+	for p.index >= len(p.items) {
+		if p.done {
+			return false
+		}
+
+		items, nextCursor, err := p.fetch(ctx, p.cursor)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.items, p.index = items, 0
+		p.cursor = nextCursor
+		p.done = nextCursor == ""
+	}
+
+	return true
+}
+
+// Item returns the current item, it must only be called after Next returned true
+func (p *Pager[T]) Item() T {
+	return p.items[p.index]
+}
+
+// Err returns the error that stopped the iteration, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// NextCursor returns the cursor of the page following the current one,
+// so that the iteration can be resumed later with NewPager.
+// It's empty once the last page has been fetched.
+func (p *Pager[T]) NextCursor() string {
+	return p.cursor
+}
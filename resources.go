@@ -0,0 +1,434 @@
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Resource is an asset as returned by the Admin API listings
+type Resource struct {
+	PublicId     string     `json:"public_id"`
+	Format       string     `json:"format"`
+	Version      int64      `json:"version"`
+	ResourceType string     `json:"resource_type"`
+	Type         string     `json:"type"`
+	CreatedAt    string     `json:"created_at"`
+	Bytes        int64      `json:"bytes"`
+	Width        int64      `json:"width"`
+	Height       int64      `json:"height"`
+	Backup       bool       `json:"backup"`
+	AccessMode   string     `json:"access_mode"`
+	URL          string     `json:"url"`
+	SecureURL    string     `json:"secure_url"`
+	Tags         []string   `json:"tags"`
+	Context      ContextMap `json:"context"`
+	AssetFolder  string     `json:"asset_folder"`
+	DisplayName  string     `json:"display_name"`
+
+	// Moderation is returned by the listings with WithModerationsFlag(true)
+	Moderation       []ResourceModeration `json:"moderation"`
+	ModerationStatus string               `json:"moderation_status"`
+}
+
+// ResourceModeration is the status of a resource in a moderation queue
+type ResourceModeration struct {
+	Kind      string `json:"kind"`
+	Status    string `json:"status"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ResourceDetails is a single asset with the analysis requested by the GetResource options
+type ResourceDetails struct {
+	Resource
+
+	Etag                  string                  `json:"etag"`
+	Placeholder           bool                    `json:"placeholder"`
+	Pages                 int64                   `json:"pages"`
+	Phash                 string                  `json:"phash"`
+	Derived               []DerivedResource       `json:"derived"`
+	DerivedNextCursor     string                  `json:"derived_next_cursor"`
+	Faces                 [][]int64               `json:"faces"`
+	Colors                []ColorScore            `json:"colors"`
+	Predominant           map[string][]ColorScore `json:"predominant"`
+	ImageMetadata         map[string]string       `json:"image_metadata"`
+	Exif                  map[string]string       `json:"exif"`
+	Coordinates           *Coordinates            `json:"coordinates"`
+	QualityAnalysis       map[string]interface{}  `json:"quality_analysis"`
+	AccessibilityAnalysis map[string]interface{}  `json:"accessibility_analysis"`
+	Versions              []ResourceVersion       `json:"versions"`
+}
+
+// DerivedResource is a transformed version of a resource
+type DerivedResource struct {
+	Id             string `json:"id"`
+	Transformation string `json:"transformation"`
+	Format         string `json:"format"`
+	Bytes          int64  `json:"bytes"`
+	URL            string `json:"url"`
+	SecureURL      string `json:"secure_url"`
+}
+
+// ColorScore is a color with its share of the image in percent,
+// Cloudinary encodes it as a ["#FFFFFF", 12.5] pair
+type ColorScore struct {
+	Color      string
+	Percentage float64
+}
+
+func (c *ColorScore) UnmarshalJSON(data []byte) error {
+	var pair []interface{}
+	if err := json.Unmarshal(data, &pair); err != nil {
+		return err
+	}
+	if len(pair) != 2 {
+		return fmt.Errorf("invalid color %s", data)
+	}
+
+	color, ok := pair[0].(string)
+	if !ok {
+		return fmt.Errorf("invalid color %s", data)
+	}
+	percentage, ok := pair[1].(float64)
+	if !ok {
+		return fmt.Errorf("invalid color %s", data)
+	}
+
+	c.Color, c.Percentage = color, percentage
+	return nil
+}
+
+// Coordinates are rectangles given as [x, y, width, height]
+type Coordinates struct {
+	Faces  [][]int64 `json:"faces"`
+	Custom [][]int64 `json:"custom"`
+}
+
+// ResourceVersion is a backed up version of a resource
+type ResourceVersion struct {
+	VersionId  string `json:"version_id"`
+	Version    int64  `json:"version"`
+	Format     string `json:"format"`
+	Size       int64  `json:"size"`
+	Time       string `json:"time"`
+	Restorable bool   `json:"restorable"`
+}
+
+type ListResourcesResponse struct {
+	Resources  []Resource `json:"resources"`
+	NextCursor string     `json:"next_cursor"`
+}
+
+// ListResources lists the resources of the given resource type (image by default),
+// optionally filtered by storage type with WithType.
+// The tags and context of the resources are returned unless WithTagsFlag(false)
+// or WithContextFlag(false) is given, their moderation with WithModerationsFlag(true).
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#get_resources
+func (as *AdminService) ListResources(ctx context.Context, opts ...SetOpts) (lr *ListResourcesResponse, resp *Response, err error) {
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	u := fmt.Sprintf("resources/%s", as.resourceTypeOrDefault(o))
+	if storageType := o.GetType(); storageType != "" {
+		u = fmt.Sprintf("%s/%s", u, storageType)
+	}
+	u = as.buildURLStrWithParams(u, as.resourceListParams(o))
+
+	return as.listResources(ctx, u)
+}
+
+// ListResourcesByPrefix lists the resources whose public ID starts with the given prefix
+func (as *AdminService) ListResourcesByPrefix(ctx context.Context, prefix string, opts ...SetOpts) (lr *ListResourcesResponse, resp *Response, err error) {
+	if prefix == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params := as.resourceListParams(o)
+	params["prefix"] = prefix
+
+	u := fmt.Sprintf("resources/%s/%s", as.resourceTypeOrDefault(o), as.storageTypeOrDefault(o))
+	u = as.buildURLStrWithParams(u, params)
+
+	return as.listResources(ctx, u)
+}
+
+// ListResourcesByTag lists the resources with the given tag
+func (as *AdminService) ListResourcesByTag(ctx context.Context, tag string, opts ...SetOpts) (lr *ListResourcesResponse, resp *Response, err error) {
+	if tag == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	u := fmt.Sprintf("resources/%s/tags/%s", as.resourceTypeOrDefault(o), url.PathEscape(tag))
+	u = as.buildURLStrWithParams(u, as.resourceListParams(o))
+
+	return as.listResources(ctx, u)
+}
+
+// ListResourcesByContext lists the resources with the given context key,
+// and with the given value if it isn't empty
+func (as *AdminService) ListResourcesByContext(ctx context.Context, key, value string, opts ...SetOpts) (lr *ListResourcesResponse, resp *Response, err error) {
+	if key == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params := as.resourceListParams(o)
+	params["key"] = key
+	if value != "" {
+		params["value"] = value
+	}
+
+	u := fmt.Sprintf("resources/%s/context", as.resourceTypeOrDefault(o))
+	u = as.buildURLStrWithParams(u, params)
+
+	return as.listResources(ctx, u)
+}
+
+// ListResourcesByModeration lists the resources with the given moderation kind and status,
+// e.g. manual and pending
+func (as *AdminService) ListResourcesByModeration(ctx context.Context, kind, status string, opts ...SetOpts) (lr *ListResourcesResponse, resp *Response, err error) {
+	if kind == "" || status == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	u := fmt.Sprintf("resources/%s/moderations/%s/%s", as.resourceTypeOrDefault(o), url.PathEscape(kind), url.PathEscape(status))
+	u = as.buildURLStrWithParams(u, as.resourceListParams(o))
+
+	return as.listResources(ctx, u)
+}
+
+// ListResourcesByIDs lists the resources with the given public IDs, up to 100 ids
+func (as *AdminService) ListResourcesByIDs(ctx context.Context, publicIds []string, opts ...SetOpts) (lr *ListResourcesResponse, resp *Response, err error) {
+	if len(publicIds) == 0 {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	u := fmt.Sprintf("resources/%s/%s", as.resourceTypeOrDefault(o), as.storageTypeOrDefault(o))
+	u = as.buildURLStrWithParams(u, as.resourceListParams(o))
+	u = as.addQueryValues(u, "public_ids[]", publicIds)
+
+	return as.listResources(ctx, u)
+}
+
+// GetResource returns the details of the resource with the given public ID.
+// The analysis to return are selected with WithColors, WithFaces, WithExif, WithImageMetadata,
+// WithPhash, WithQualityAnalysis, WithAccessibilityAnalysis, WithCoordinates, WithPages and WithVersions,
+// the derived resources are paged with WithMaxResults and WithNextCursor.
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#get_details_of_a_single_resource
+func (as *AdminService) GetResource(ctx context.Context, publicId string, opts ...SetOpts) (rd *ResourceDetails, resp *Response, err error) {
+	if publicId == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params := make(map[string]string)
+	flags := map[string]*bool{
+		"colors":                 o.Colors,
+		"faces":                  o.Faces,
+		"exif":                   o.Exif,
+		"image_metadata":         o.ImageMetadata,
+		"phash":                  o.Phash,
+		"quality_analysis":       o.QualityAnalysis,
+		"accessibility_analysis": o.AccessibilityAnalysis,
+		"coordinates":            o.Coordinates,
+		"pages":                  o.Pages,
+		"versions":               o.Versions,
+	}
+	for key, flag := range flags {
+		if flag != nil {
+			params[key] = strconv.FormatBool(*flag)
+		}
+	}
+	if maxResults := o.GetMaxResults(); maxResults > 0 {
+		params["max_results"] = strconv.Itoa(maxResults)
+	}
+	if nextCursor := o.GetNextCursor(); nextCursor != "" {
+		params["derived_next_cursor"] = nextCursor
+	}
+
+	u := fmt.Sprintf("resources/%s/%s/%s", as.resourceTypeOrDefault(o), as.storageTypeOrDefault(o), escapePublicId(publicId))
+	u = as.buildURLStrWithParams(u, params)
+
+	request, err := as.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	as.withBasicAuthentication(request)
+
+	rd = new(ResourceDetails)
+	resp, err = as.client.Do(ctx, request, rd)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rd, resp, nil
+}
+
+// updateResourceParams are the options that UpdateResource sends to the Admin API
+var updateResourceParams = []string{
+	"access_control", "asset_folder", "auto_tagging", "background_removal", "categorization", "context",
+	"custom_coordinates", "detection", "display_name", "face_coordinates", "ocr", "raw_convert", "tags",
+}
+
+// UpdateResource updates the tags, context, moderation status, access control
+// or add-ons of the resource with the given public ID.
+// The tags and context given replace the existing ones.
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#update_details_of_an_existing_resource
+func (as *AdminService) UpdateResource(ctx context.Context, publicId string, opts ...SetOpts) (rd *ResourceDetails, resp *Response, err error) {
+	if publicId == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	optMap, err := o.toMap()
+	if err != nil {
+		return nil, nil, err
+	}
+	params := make(map[string]interface{})
+	for _, key := range updateResourceParams {
+		if val, ok := optMap[key]; ok {
+			params[key] = val
+		}
+	}
+	if moderationStatus := o.GetModerationStatus(); moderationStatus != "" {
+		params["moderation_status"] = moderationStatus
+	}
+
+	u := fmt.Sprintf("resources/%s/%s/%s", as.resourceTypeOrDefault(o), as.storageTypeOrDefault(o), escapePublicId(publicId))
+
+	request, err := as.client.NewRequest("POST", u, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	as.withBasicAuthentication(request)
+
+	rd = new(ResourceDetails)
+	resp, err = as.client.Do(ctx, request, rd)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rd, resp, nil
+}
+
+// NewResourcesPager returns a Pager over all the resources returned by list,
+// which is usually one of the ListResources methods:
+//
+//	pager := NewResourcesPager(func(ctx context.Context, opts ...SetOpts) (*ListResourcesResponse, *Response, error) {
+//		return client.Admin.ListResourcesByTag(ctx, "kitten", opts...)
+//	}, WithMaxResults(500))
+func NewResourcesPager(list func(ctx context.Context, opts ...SetOpts) (*ListResourcesResponse, *Response, error), opts ...SetOpts) *Pager[Resource] {
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	return NewPager(o.GetNextCursor(), func(ctx context.Context, cursor string) ([]Resource, string, error) {
+		lr, _, err := list(ctx, append(opts[:len(opts):len(opts)], WithNextCursor(cursor))...)
+		if err != nil {
+			return nil, "", err
+		}
+		return lr.Resources, lr.NextCursor, nil
+	})
+}
+
+func (as *AdminService) listResources(ctx context.Context, u string) (lr *ListResourcesResponse, resp *Response, err error) {
+	request, err := as.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	as.withBasicAuthentication(request)
+
+	lr = new(ListResourcesResponse)
+	resp, err = as.client.Do(ctx, request, lr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lr, resp, nil
+}
+
+// resourceListParams returns the query parameters of the resource listings,
+// the tags and context are returned by default so that the Resource fields are filled
+func (as *AdminService) resourceListParams(o *Options) map[string]string {
+	params := as.listParams(o)
+
+	params["tags"] = strconv.FormatBool(o.GetTagsFlag())
+	params["context"] = strconv.FormatBool(o.GetContextFlag())
+	if o.GetModerationsFlag() {
+		params["moderations"] = "true"
+	}
+
+	return params
+}
+
+// listParams returns the query parameters shared by the Admin API listings
+func (as *AdminService) listParams(o *Options) map[string]string {
+	params := make(map[string]string)
+
+	if maxResults := o.GetMaxResults(); maxResults > 0 {
+		params["max_results"] = strconv.Itoa(maxResults)
+	}
+	if nextCursor := o.GetNextCursor(); nextCursor != "" {
+		params["next_cursor"] = nextCursor
+	}
+	if direction := o.GetDirection(); direction != "" {
+		params["direction"] = direction
+	}
+	if startAt := o.GetStartAt(); startAt != "" {
+		params["start_at"] = startAt
+	}
+
+	return params
+}
+
+func (as *AdminService) resourceTypeOrDefault(o *Options) string {
+	if resourceType := o.GetResourceType(); resourceType != "" {
+		return resourceType
+	}
+	return string(ResourceTypeImage)
+}
+
+func (as *AdminService) storageTypeOrDefault(o *Options) string {
+	if storageType := o.GetType(); storageType != "" {
+		return storageType
+	}
+	return "upload"
+}
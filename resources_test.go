@@ -0,0 +1,40 @@
+package cloudinary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListResourcesFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []SetOpts
+		want string
+	}{
+		{"default", nil, "context=true&tags=true"},
+		{"without tags and context", []SetOpts{WithTagsFlag(false), WithContextFlag(false)}, "context=false&tags=false"},
+		{"with moderations", []SetOpts{WithModerationsFlag(true), WithMaxResults(10)}, "context=true&max_results=10&moderations=true&tags=true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1_1/demo/resources/image" || r.URL.RawQuery != tt.want {
+					t.Errorf("request = %s, want query %s", r.URL, tt.want)
+				}
+				fmt.Fprint(w, `{"resources":[{"public_id":"sample","tags":["kitten"],"context":{"custom":{"alt":"cat"}},
+					"moderation":[{"kind":"manual","status":"approved"}]}]}`)
+			})
+
+			lr, _, err := c.Admin.ListResources(context.Background(), tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := lr.Resources[0]
+			if len(r.Tags) != 1 || r.Context["alt"] != "cat" || r.Moderation[0].Status != "approved" {
+				t.Errorf("resource = %+v", r)
+			}
+		})
+	}
+}
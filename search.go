@@ -0,0 +1,264 @@
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// searchReservedChars are escaped with a backslash in search values
+const searchReservedChars = `!(){}[]*^~?:\=&><"`
+
+// SearchExpression is a Search API expression, e.g. resource_type:image AND tags=kitten AND uploaded_at>1d
+// Values given to the expression functions are escaped, so they're always matched literally.
+//
+// Documentation: https://cloudinary.com/documentation/search_api#expressions
+type SearchExpression struct {
+	expr string
+	op   string // The boolean operator joining the terms of a compound expression
+}
+
+// SearchMatch matches the tokens of a field (field:value)
+func SearchMatch(field, value string) SearchExpression {
+	return SearchExpression{expr: field + ":" + escapeSearchValue(value)}
+}
+
+// SearchEquals matches the exact value of a field (field=value)
+func SearchEquals(field, value string) SearchExpression {
+	return SearchExpression{expr: field + "=" + escapeSearchValue(value)}
+}
+
+// SearchPrefix matches the fields that start with the given value (field:value*)
+func SearchPrefix(field, value string) SearchExpression {
+	return SearchExpression{expr: field + ":" + escapeSearchValue(value) + "*"}
+}
+
+// SearchGreaterThan matches the fields greater than value (field>value), e.g. SearchGreaterThan("uploaded_at", "1d")
+func SearchGreaterThan(field, value string) SearchExpression {
+	return SearchExpression{expr: field + ">" + escapeSearchValue(value)}
+}
+
+// SearchGreaterOrEqual matches the fields greater than or equal to value (field>=value)
+func SearchGreaterOrEqual(field, value string) SearchExpression {
+	return SearchExpression{expr: field + ">=" + escapeSearchValue(value)}
+}
+
+// SearchLessThan matches the fields less than value (field<value)
+func SearchLessThan(field, value string) SearchExpression {
+	return SearchExpression{expr: field + "<" + escapeSearchValue(value)}
+}
+
+// SearchLessOrEqual matches the fields less than or equal to value (field<=value)
+func SearchLessOrEqual(field, value string) SearchExpression {
+	return SearchExpression{expr: field + "<=" + escapeSearchValue(value)}
+}
+
+// SearchTerm matches the value in any of the string fields
+func SearchTerm(value string) SearchExpression {
+	return SearchExpression{expr: escapeSearchValue(value)}
+}
+
+// SearchRaw uses the given expression as is, it isn't escaped.
+// It's wrapped in parentheses when combined with other expressions.
+func SearchRaw(expr string) SearchExpression {
+	if strings.ContainsAny(strings.TrimSpace(expr), " \t") {
+		return SearchExpression{expr: expr, op: "RAW"}
+	}
+	return SearchExpression{expr: expr}
+}
+
+// And matches when e and all the other expressions match
+func (e SearchExpression) And(others ...SearchExpression) SearchExpression {
+	return e.join("AND", others)
+}
+
+// Or matches when e or any of the other expressions match
+func (e SearchExpression) Or(others ...SearchExpression) SearchExpression {
+	return e.join("OR", others)
+}
+
+// SearchNot matches when e doesn't match
+func SearchNot(e SearchExpression) SearchExpression {
+	return SearchExpression{expr: "NOT " + e.group()}
+}
+
+func (e SearchExpression) String() string {
+	return e.expr
+}
+
+func (e SearchExpression) join(op string, others []SearchExpression) SearchExpression {
+	kept := make([]SearchExpression, 0, len(others)+1)
+	for _, t := range append([]SearchExpression{e}, others...) {
+		if t.expr != "" {
+			kept = append(kept, t)
+		}
+	}
+
+	switch len(kept) {
+	case 0:
+		return SearchExpression{}
+	case 1:
+		// The only term keeps its operator so that it's still grouped when negated or combined
+		return kept[0]
+	}
+
+	terms := make([]string, len(kept))
+	for i, t := range kept {
+		if t.op != "" && t.op != op {
+			terms[i] = t.group()
+			continue
+		}
+		terms[i] = t.expr
+	}
+	return SearchExpression{expr: strings.Join(terms, " "+op+" "), op: op}
+}
+
+// group wraps compound expressions in parentheses
+func (e SearchExpression) group() string {
+	if e.op == "" {
+		return e.expr
+	}
+	return "(" + e.expr + ")"
+}
+
+// escapeSearchValue escapes the reserved characters and the white spaces with a backslash
+func escapeSearchValue(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if strings.ContainsRune(searchReservedChars, r) || r == ' ' || r == '\t' {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// SearchQuery is a Search API request built with chained calls:
+//
+//	expr := SearchMatch("resource_type", "image").And(SearchEquals("tags", "kitten"), SearchGreaterThan("uploaded_at", "1d"))
+//	q := NewSearchQuery(expr).
+//		SortBy("created_at", "desc").
+//		Aggregate("format").
+//		WithField("tags").
+//		MaxResults(100)
+type SearchQuery struct {
+	expression string
+	sortBy     []map[string]string
+	aggregate  []string
+	withField  []string
+	maxResults int
+	nextCursor string
+}
+
+type searchQueryBody struct {
+	Expression string              `json:"expression,omitempty"`
+	SortBy     []map[string]string `json:"sort_by,omitempty"`
+	Aggregate  []string            `json:"aggregate,omitempty"`
+	WithField  []string            `json:"with_field,omitempty"`
+	MaxResults int                 `json:"max_results,omitempty"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
+
+// NewSearchQuery returns a query for the given expression, an empty expression matches every resource
+func NewSearchQuery(expression SearchExpression) *SearchQuery {
+	return &SearchQuery{expression: expression.String()}
+}
+
+// SortBy sorts the results by field, direction is asc or desc.
+// Sorting by several fields is done by calling SortBy for each of them.
+func (q *SearchQuery) SortBy(field, direction string) *SearchQuery {
+	q.sortBy = append(q.sortBy, map[string]string{field: direction})
+	return q
+}
+
+// Aggregate returns the counts of the resources by the values of the given fields, e.g. format or resource_type
+func (q *SearchQuery) Aggregate(fields ...string) *SearchQuery {
+	q.aggregate = append(q.aggregate, fields...)
+	return q
+}
+
+// WithField adds the given fields to the resources, e.g. tags, context, image_metadata
+func (q *SearchQuery) WithField(fields ...string) *SearchQuery {
+	q.withField = append(q.withField, fields...)
+	return q
+}
+
+// MaxResults sets the page size, up to 500
+func (q *SearchQuery) MaxResults(max int) *SearchQuery {
+	q.maxResults = max
+	return q
+}
+
+// NextCursor sets the cursor of the page to return
+func (q *SearchQuery) NextCursor(cursor string) *SearchQuery {
+	q.nextCursor = cursor
+	return q
+}
+
+func (q *SearchQuery) body() searchQueryBody {
+	return searchQueryBody{
+		Expression: q.expression,
+		SortBy:     q.sortBy,
+		Aggregate:  q.aggregate,
+		WithField:  q.withField,
+		MaxResults: q.maxResults,
+		NextCursor: q.nextCursor,
+	}
+}
+
+// ToJSON returns the request body sent to the Search API
+func (q *SearchQuery) ToJSON() string {
+	b := new(strings.Builder)
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+	enc.Encode(q.body())
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+type SearchResponse struct {
+	TotalCount int64      `json:"total_count"`
+	Time       int64      `json:"time"`
+	Resources  []Resource `json:"resources"`
+	NextCursor string     `json:"next_cursor"`
+
+	// Aggregations are the resource counts by value of each aggregated field,
+	// e.g. {"format": {"png": 10, "jpg": 4}}
+	Aggregations map[string]map[string]int64 `json:"aggregations"`
+}
+
+// Search returns the resources matching the query
+//
+// Documentation: https://cloudinary.com/documentation/search_api
+func (as *AdminService) Search(ctx context.Context, q *SearchQuery) (sr *SearchResponse, resp *Response, err error) {
+	if q == nil {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	request, err := as.client.NewRequest("POST", "resources/search", q.body())
+	if err != nil {
+		return nil, nil, err
+	}
+	as.withBasicAuthentication(request)
+
+	sr = new(SearchResponse)
+	resp, err = as.client.Do(ctx, request, sr)
+	if err != nil {
+		return nil, resp, err
+	}
+	return sr, resp, nil
+}
+
+// SearchPager returns a Pager over all the resources matching the query.
+// The query must not be modified while the pager is used.
+func (as *AdminService) SearchPager(q *SearchQuery) *Pager[Resource] {
+	return NewPager(q.nextCursor, func(ctx context.Context, cursor string) ([]Resource, string, error) {
+		page := *q
+		page.nextCursor = cursor
+		sr, _, err := as.Search(ctx, &page)
+		if err != nil {
+			return nil, "", err
+		}
+		return sr.Resources, sr.NextCursor, nil
+	})
+}
@@ -0,0 +1,64 @@
+package cloudinary
+
+import "testing"
+
+func TestSearchExpression(t *testing.T) {
+	tests := []struct {
+		name string
+		expr SearchExpression
+		want string
+	}{
+		{"match", SearchMatch("tags", "kitten"), "tags:kitten"},
+		{"escaped value", SearchEquals("public_id", `a b:(c)*"d"`), `public_id=a\ b\:\(c\)\*\"d\"`},
+		{"prefix", SearchPrefix("public_id", "folder/a b"), `public_id:folder/a\ b*`},
+		{"comparisons", SearchGreaterThan("bytes", "1000").And(SearchLessOrEqual("width", "200")), "bytes>1000 AND width<=200"},
+		{"term", SearchTerm("cat!"), `cat\!`},
+		{
+			"and",
+			SearchMatch("resource_type", "image").And(SearchEquals("tags", "kitten"), SearchGreaterThan("uploaded_at", "1d")),
+			"resource_type:image AND tags=kitten AND uploaded_at>1d",
+		},
+		{"or inside and", SearchMatch("a", "1").And(SearchMatch("b", "2").Or(SearchMatch("c", "3"))), "a:1 AND (b:2 OR c:3)"},
+		{"and inside or", SearchMatch("a", "1").Or(SearchMatch("b", "2").And(SearchMatch("c", "3"))), "a:1 OR (b:2 AND c:3)"},
+		{"same operator is flattened", SearchMatch("a", "1").And(SearchMatch("b", "2").And(SearchMatch("c", "3"))), "a:1 AND b:2 AND c:3"},
+		{"not", SearchNot(SearchMatch("tags", "dog")), "NOT tags:dog"},
+		{"not compound", SearchNot(SearchMatch("a", "1").Or(SearchMatch("b", "2"))), "NOT (a:1 OR b:2)"},
+		{"empty terms are skipped", SearchExpression{}.And(SearchMatch("a", "1"), SearchExpression{}), "a:1"},
+		{
+			"single compound term keeps its grouping",
+			SearchNot(SearchMatch("a", "1").And(SearchMatch("b", "2")).And(SearchExpression{})),
+			"NOT (a:1 AND b:2)",
+		},
+		{"raw is not escaped", SearchRaw("format:(jpg OR png)"), "format:(jpg OR png)"},
+		{"raw is grouped", SearchMatch("a", "1").And(SearchRaw("b:2 OR c:3")), "a:1 AND (b:2 OR c:3)"},
+		{"simple raw is not grouped", SearchMatch("a", "1").And(SearchRaw("b:2")), "a:1 AND b:2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.expr.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchQueryToJSON(t *testing.T) {
+	q := NewSearchQuery(SearchMatch("resource_type", "image").And(SearchGreaterThan("uploaded_at", "1d"))).
+		SortBy("created_at", "desc").
+		SortBy("public_id", "asc").
+		Aggregate("format").
+		WithField("tags", "context").
+		MaxResults(100).
+		NextCursor("abc")
+
+	want := `{"expression":"resource_type:image AND uploaded_at>1d",` +
+		`"sort_by":[{"created_at":"desc"},{"public_id":"asc"}],` +
+		`"aggregate":["format"],"with_field":["tags","context"],"max_results":100,"next_cursor":"abc"}`
+	if got := q.ToJSON(); got != want {
+		t.Errorf("ToJSON() = %s, want %s", got, want)
+	}
+
+	if got := NewSearchQuery(SearchExpression{}).ToJSON(); got != "{}" {
+		t.Errorf("ToJSON() = %s, want {}", got)
+	}
+}
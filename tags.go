@@ -0,0 +1,140 @@
+package cloudinary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// maxTagPublicIds is the maximum number of public IDs of a single tags or context request
+const maxTagPublicIds = 1000
+
+const (
+	TagCommandAdd       = "add"
+	TagCommandRemove    = "remove"
+	TagCommandReplace   = "replace"
+	TagCommandRemoveAll = "remove_all"
+)
+
+type TagsResponse struct {
+	PublicIds []string `json:"public_ids"`
+}
+
+type ListTagsResponse struct {
+	Tags       []string `json:"tags"`
+	NextCursor string   `json:"next_cursor"`
+}
+
+// AddTag adds the tag to the assets with the given public IDs
+//
+// Documentation: https://cloudinary.com/documentation/image_upload_api_reference#tags_method
+func (us *UploadService) AddTag(ctx context.Context, tag string, publicIds []string, opts ...SetOpts) (tr *TagsResponse, resp *Response, err error) {
+	if tag == "" {
+		return nil, nil, errors.New("invalid tag")
+	}
+	return us.updateTags(ctx, TagCommandAdd, tag, publicIds, opts...)
+}
+
+// RemoveTag removes the tag from the assets with the given public IDs
+func (us *UploadService) RemoveTag(ctx context.Context, tag string, publicIds []string, opts ...SetOpts) (tr *TagsResponse, resp *Response, err error) {
+	if tag == "" {
+		return nil, nil, errors.New("invalid tag")
+	}
+	return us.updateTags(ctx, TagCommandRemove, tag, publicIds, opts...)
+}
+
+// ReplaceTag replaces all the tags of the assets with the given public IDs by tag,
+// several tags can be given as a comma separated list
+func (us *UploadService) ReplaceTag(ctx context.Context, tag string, publicIds []string, opts ...SetOpts) (tr *TagsResponse, resp *Response, err error) {
+	if tag == "" {
+		return nil, nil, errors.New("invalid tag")
+	}
+	return us.updateTags(ctx, TagCommandReplace, tag, publicIds, opts...)
+}
+
+// RemoveAllTags removes all the tags of the assets with the given public IDs
+func (us *UploadService) RemoveAllTags(ctx context.Context, publicIds []string, opts ...SetOpts) (tr *TagsResponse, resp *Response, err error) {
+	return us.updateTags(ctx, TagCommandRemoveAll, "", publicIds, opts...)
+}
+
+// updateTags runs the tags command on the public IDs
+func (us *UploadService) updateTags(ctx context.Context, command, tag string, publicIds []string, opts ...SetOpts) (tr *TagsResponse, resp *Response, err error) {
+	params := map[string]interface{}{"command": command}
+	if tag != "" {
+		params["tag"] = tag
+	}
+
+	tr = new(TagsResponse)
+	tr.PublicIds, resp, err = us.updatePublicIds(ctx, "tags", params, publicIds, opts...)
+	return tr, resp, err
+}
+
+// updatePublicIds calls the given Upload API method on the public IDs in batches of maxTagPublicIds,
+// the public IDs returned by every batch are merged
+func (us *UploadService) updatePublicIds(ctx context.Context, method string, params map[string]interface{}, publicIds []string, opts ...SetOpts) (updated []string, resp *Response, err error) {
+	if len(publicIds) == 0 {
+		return nil, nil, errors.New("invalid public ids")
+	}
+
+	for start := 0; start < len(publicIds); start += maxTagPublicIds {
+		end := start + maxTagPublicIds
+		if end > len(publicIds) {
+			end = len(publicIds)
+		}
+
+		opt := new(Options)
+		for _, o := range opts {
+			o(opt)
+		}
+		opt.isUnsignedUpload = false
+		for field, val := range params {
+			opt.setExtraParam(field, val)
+		}
+		opt.setExtraParam("public_ids", publicIds[start:end])
+
+		u := fmt.Sprintf("%s/%s", opt.uploadResourceType(), method)
+
+		batch := new(struct {
+			PublicIds []string `json:"public_ids"`
+		})
+		resp, err = us.postSigned(ctx, u, opt, batch)
+		if err != nil {
+			return updated, resp, err
+		}
+		updated = append(updated, batch.PublicIds...)
+	}
+
+	return updated, resp, nil
+}
+
+// ListTags lists the tags of the given resource type (image by default)
+// that start with prefix, every tag is listed when prefix is empty
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#get_tags
+func (as *AdminService) ListTags(ctx context.Context, prefix string, opts ...SetOpts) (lt *ListTagsResponse, resp *Response, err error) {
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	params := as.listParams(o)
+	if prefix != "" {
+		params["prefix"] = prefix
+	}
+
+	u := fmt.Sprintf("tags/%s", as.resourceTypeOrDefault(o))
+	u = as.buildURLStrWithParams(u, params)
+
+	request, err := as.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	as.withBasicAuthentication(request)
+
+	lt = new(ListTagsResponse)
+	resp, err = as.client.Do(ctx, request, lt)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lt, resp, nil
+}
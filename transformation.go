@@ -0,0 +1,217 @@
+package cloudinary
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Transformation describes a Cloudinary transformation, e.g. c_fill,w_300/e_sepia
+// Every setter returns a new Transformation, so a value can be shared and extended safely:
+//
+//	thumb := NewTransformation().Crop("fill").Width(300).Height(200)
+//	sepiaThumb := thumb.Chain().Effect("sepia")
+//
+// Documentation: https://cloudinary.com/documentation/transformation_reference
+type Transformation struct {
+	components [][]transformationParam
+	format     string
+}
+
+type transformationParam struct {
+	key   string
+	value string
+}
+
+func NewTransformation() Transformation {
+	return Transformation{}
+}
+
+// Crop sets the cropping mode (c_), e.g. fill, fit, scale, crop, thumb, pad
+func (t Transformation) Crop(mode string) Transformation {
+	return t.set("c", mode)
+}
+
+// Width sets the width (w_) in pixels
+func (t Transformation) Width(width int) Transformation {
+	return t.set("w", strconv.Itoa(width))
+}
+
+// Height sets the height (h_) in pixels
+func (t Transformation) Height(height int) Transformation {
+	return t.set("h", strconv.Itoa(height))
+}
+
+// AspectRatio sets the aspect ratio (ar_), e.g. 16:9 or 1.5
+func (t Transformation) AspectRatio(ratio string) Transformation {
+	return t.set("ar", ratio)
+}
+
+// Gravity sets the gravity (g_), e.g. auto, face, north_east
+func (t Transformation) Gravity(gravity string) Transformation {
+	return t.set("g", gravity)
+}
+
+// Quality sets the quality (q_), e.g. auto, auto:good or 80
+func (t Transformation) Quality(quality string) Transformation {
+	return t.set("q", quality)
+}
+
+// FetchFormat sets the delivery format (f_), e.g. auto
+func (t Transformation) FetchFormat(format string) Transformation {
+	return t.set("f", format)
+}
+
+// Format sets the file format of the result, e.g. jpg or webp.
+// It's used as the extension of eager transformations and delivery URLs,
+// so it isn't part of the transformation string.
+func (t Transformation) Format(format string) Transformation {
+	t.format = format
+	return t
+}
+
+// Effect applies an effect (e_) with its optional arguments, e.g. Effect("blur", "300") gives e_blur:300
+func (t Transformation) Effect(name string, args ...string) Transformation {
+	return t.set("e", strings.Join(append([]string{name}, args...), ":"))
+}
+
+// Overlay adds the asset with the given public ID as an overlay (l_)
+func (t Transformation) Overlay(publicId string) Transformation {
+	return t.set("l", strings.ReplaceAll(publicId, "/", ":"))
+}
+
+// Underlay adds the asset with the given public ID as an underlay (u_)
+func (t Transformation) Underlay(publicId string) Transformation {
+	return t.set("u", strings.ReplaceAll(publicId, "/", ":"))
+}
+
+// X sets the horizontal position (x_) of a crop or an overlay
+func (t Transformation) X(x int) Transformation {
+	return t.set("x", strconv.Itoa(x))
+}
+
+// Y sets the vertical position (y_) of a crop or an overlay
+func (t Transformation) Y(y int) Transformation {
+	return t.set("y", strconv.Itoa(y))
+}
+
+// Radius rounds the corners (r_), e.g. 20 or max
+func (t Transformation) Radius(radius string) Transformation {
+	return t.set("r", radius)
+}
+
+// Angle rotates (a_) by the given degrees or mode, e.g. 90 or auto_right
+func (t Transformation) Angle(angle string) Transformation {
+	return t.set("a", angle)
+}
+
+// Opacity sets the opacity (o_) between 0 and 100
+func (t Transformation) Opacity(opacity int) Transformation {
+	return t.set("o", strconv.Itoa(opacity))
+}
+
+// Background sets the background color (b_), e.g. white or rgb:9090ff
+func (t Transformation) Background(color string) Transformation {
+	return t.set("b", color)
+}
+
+// Flags sets the flags (fl_), e.g. Flags("layer_apply", "relative")
+func (t Transformation) Flags(flags ...string) Transformation {
+	return t.set("fl", strings.Join(flags, "."))
+}
+
+// DPR sets the device pixel ratio (dpr_), e.g. 2.0 or auto
+func (t Transformation) DPR(dpr string) Transformation {
+	return t.set("dpr", dpr)
+}
+
+// Named applies a named transformation (t_)
+func (t Transformation) Named(name string) Transformation {
+	return t.set("t", name)
+}
+
+// Raw adds a raw transformation string to the current component, e.g. Raw("e_grayscale"),
+// for parameters that don't have a setter
+func (t Transformation) Raw(raw string) Transformation {
+	return t.add(transformationParam{value: raw})
+}
+
+// Chain starts a new component, the following setters apply to the result of the previous ones
+func (t Transformation) Chain() Transformation {
+	t.components = append(t.copyComponents(1), nil)
+	return t
+}
+
+// IsEmpty reports whether t doesn't have any transformation parameter nor format
+func (t Transformation) IsEmpty() bool {
+	return t.String() == "" && t.format == ""
+}
+
+// String returns the Cloudinary syntax of the transformation.
+// The parameters of a component are sorted by key, raw transformations come last.
+func (t Transformation) String() string {
+	components := make([]string, 0, len(t.components))
+	for _, c := range t.components {
+		params := make([]string, 0, len(c))
+		raws := make([]string, 0)
+		for _, p := range c {
+			if p.key == "" {
+				raws = append(raws, p.value)
+				continue
+			}
+			params = append(params, p.key+"_"+p.value)
+		}
+		sort.Strings(params)
+		params = append(params, raws...)
+		if len(params) == 0 {
+			continue
+		}
+		components = append(components, strings.Join(params, ","))
+	}
+	return strings.Join(components, "/")
+}
+
+// eagerString returns the transformation followed by its format, as expected by the eager parameter
+func (t Transformation) eagerString() string {
+	s := t.String()
+	if t.format == "" {
+		return s
+	}
+	if s == "" {
+		return t.format
+	}
+	return s + "/" + t.format
+}
+
+// set replaces the parameter with the same key in the current component
+func (t Transformation) set(key, value string) Transformation {
+	return t.add(transformationParam{key: key, value: value})
+}
+
+func (t Transformation) add(param transformationParam) Transformation {
+	components := t.copyComponents(0)
+	if len(components) == 0 {
+		components = append(components, nil)
+	}
+	last := len(components) - 1
+
+	current := make([]transformationParam, 0, len(components[last])+1)
+	for _, p := range components[last] {
+		if param.key != "" && p.key == param.key {
+			continue
+		}
+		current = append(current, p)
+	}
+	components[last] = append(current, param)
+
+	t.components = components
+	return t
+}
+
+// copyComponents copies the component list so that the receiver is never modified,
+// extra is the capacity reserved for new components
+func (t Transformation) copyComponents(extra int) [][]transformationParam {
+	components := make([][]transformationParam, len(t.components), len(t.components)+extra)
+	copy(components, t.components)
+	return components
+}
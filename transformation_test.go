@@ -0,0 +1,99 @@
+package cloudinary
+
+import "testing"
+
+func TestTransformationString(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Transformation
+		want string
+	}{
+		{"empty", NewTransformation(), ""},
+		{"sorted parameters", NewTransformation().Width(300).Crop("fill").Height(200).Gravity("face"), "c_fill,g_face,h_200,w_300"},
+		{"replaced parameter", NewTransformation().Width(100).Width(300), "w_300"},
+		{"effect arguments", NewTransformation().Effect("blur", "300"), "e_blur:300"},
+		{"overlay in a folder", NewTransformation().Overlay("logos/brand"), "l_logos:brand"},
+		{"flags", NewTransformation().Flags("layer_apply", "relative"), "fl_layer_apply.relative"},
+		{"raw comes last", NewTransformation().Raw("e_grayscale").Width(100).Crop("scale"), "c_scale,w_100,e_grayscale"},
+		{"raw keeps its order", NewTransformation().Raw("z_1").Raw("a_2"), "z_1,a_2"},
+		{"chain", NewTransformation().Crop("fill").Width(300).Chain().Effect("sepia"), "c_fill,w_300/e_sepia"},
+		{"same key in chained components", NewTransformation().Width(300).Chain().Width(100), "w_300/w_100"},
+		{"empty components are skipped", NewTransformation().Chain().Chain().Radius("max"), "r_max"},
+		{"format isn't a parameter", NewTransformation().Width(100).Format("png"), "w_100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransformationImmutable(t *testing.T) {
+	base := NewTransformation().Crop("fill").Width(300)
+
+	sepia := base.Chain().Effect("sepia")
+	grayscale := base.Chain().Effect("grayscale")
+	wide := base.Width(600)
+	base.Raw("e_blur")
+
+	if got := base.String(); got != "c_fill,w_300" {
+		t.Errorf("base = %s, want c_fill,w_300", got)
+	}
+	if got := sepia.String(); got != "c_fill,w_300/e_sepia" {
+		t.Errorf("sepia = %s, want c_fill,w_300/e_sepia", got)
+	}
+	if got := grayscale.String(); got != "c_fill,w_300/e_grayscale" {
+		t.Errorf("grayscale = %s, want c_fill,w_300/e_grayscale", got)
+	}
+	if got := wide.String(); got != "c_fill,w_600" {
+		t.Errorf("wide = %s, want c_fill,w_600", got)
+	}
+
+	// Extending a chained transformation doesn't change the ones sharing its components
+	thumb := base.Chain().Effect("sepia")
+	_ = thumb.Radius("max")
+	_ = thumb.Chain().Angle("90")
+	if got := thumb.String(); got != "c_fill,w_300/e_sepia" {
+		t.Errorf("thumb = %s, want c_fill,w_300/e_sepia", got)
+	}
+}
+
+func TestTransformationEagerString(t *testing.T) {
+	tests := []struct {
+		name string
+		tr   Transformation
+		want string
+	}{
+		{"without format", NewTransformation().Width(100), "w_100"},
+		{"with format", NewTransformation().Crop("fill").Width(100).Format("webp"), "c_fill,w_100/webp"},
+		{"format only", NewTransformation().Format("png"), "png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tr.eagerString(); got != tt.want {
+				t.Errorf("eagerString() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithEager(t *testing.T) {
+	o := new(Options)
+	WithEager(
+		NewTransformation().Crop("fill").Width(300).Format("jpg"),
+		NewTransformation(),
+		NewTransformation().Width(100).Chain().Effect("sepia"),
+	)(o)
+
+	if o.Eager == nil || *o.Eager != "c_fill,w_300/jpg|w_100/e_sepia" {
+		t.Errorf("Eager = %v, want c_fill,w_300/jpg|w_100/e_sepia", o.Eager)
+	}
+
+	o = new(Options)
+	WithEager(NewTransformation())(o)
+	if o.Eager != nil {
+		t.Errorf("Eager = %s, want nil", *o.Eager)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha1"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -49,12 +48,78 @@ type UploadResponse struct {
 	SecureURL        string          `json:"secure_url"`
 	AccessMode       string          `json:"access_mode"`
 	OriginalFilename string          `json:"original_filename"`
+	AssetFolder      string          `json:"asset_folder"`
+	DisplayName      string          `json:"display_name"`
+	Context          ContextMap      `json:"context"`
 	Colors           [][]interface{} `json:"colors"`
+
+	// Video and audio only fields
+	Duration  float64    `json:"duration"`
+	FrameRate float64    `json:"frame_rate"`
+	BitRate   int64      `json:"bit_rate"`
+	NbFrames  int64      `json:"nb_frames"`
+	Rotation  int64      `json:"rotation"`
+	IsAudio   bool       `json:"is_audio"`
+	Audio     *AudioInfo `json:"audio"`
+	Video     *VideoInfo `json:"video"`
+
+	// Pages is the number of pages of multi-page files such as PDFs and animated GIFs
+	Pages int64 `json:"pages"`
+
+	// Eager contains the transformations generated with WithEager
+	Eager []EagerResult `json:"eager"`
+
+	// DeleteToken deletes the asset with DeleteByToken, it's returned with WithReturnDeleteToken
+	DeleteToken string `json:"delete_token"`
+}
+
+type EagerResult struct {
+	Transformation string `json:"transformation"`
+	Width          int64  `json:"width"`
+	Height         int64  `json:"height"`
+	Bytes          int64  `json:"bytes"`
+	Format         string `json:"format"`
+	URL            string `json:"url"`
+	SecureURL      string `json:"secure_url"`
+}
+
+type AudioInfo struct {
+	Codec         string `json:"codec"`
+	BitRate       string `json:"bit_rate"`
+	Frequency     int64  `json:"frequency"`
+	Channels      int64  `json:"channels"`
+	ChannelLayout string `json:"channel_layout"`
+}
+
+type VideoInfo struct {
+	PixFormat string `json:"pix_format"`
+	Codec     string `json:"codec"`
+	Level     int64  `json:"level"`
+	Profile   string `json:"profile"`
+	BitRate   string `json:"bit_rate"`
+	Dar       string `json:"dar"`
+	TimeBase  string `json:"time_base"`
 }
 
 // UploadImage handle signed uploading image to Cloudinary
-// Signed request are required `signature` parameters
+// Signed request are required `signature` parameters.
+// The resource type can be changed with WithResourceType, e.g. `auto`
+// to let Cloudinary detect it.
 func (us *UploadService) UploadImage(ctx context.Context, filePath string, opts ...SetOpts) (ur *UploadResponse, r *Response, err error) {
+	return us.upload(ctx, filePath, opts...)
+}
+
+// UploadVideo handle signed uploading video and audio files to Cloudinary
+func (us *UploadService) UploadVideo(ctx context.Context, filePath string, opts ...SetOpts) (ur *UploadResponse, r *Response, err error) {
+	return us.upload(ctx, filePath, withForcedResourceType(opts, ResourceTypeVideo)...)
+}
+
+// UploadRaw handle signed uploading raw files (any file that isn't an image or a video) to Cloudinary
+func (us *UploadService) UploadRaw(ctx context.Context, filePath string, opts ...SetOpts) (ur *UploadResponse, r *Response, err error) {
+	return us.upload(ctx, filePath, withForcedResourceType(opts, ResourceTypeRaw)...)
+}
+
+func (us *UploadService) upload(ctx context.Context, filePath string, opts ...SetOpts) (ur *UploadResponse, r *Response, err error) {
 	if strings.TrimSpace(filePath) == "" {
 		return nil, nil, errors.New("invalid file")
 	}
@@ -64,24 +129,21 @@ func (us *UploadService) UploadImage(ctx context.Context, filePath string, opts
 	}
 	opt.isUnsignedUpload = false
 
-	u := fmt.Sprintf("image/upload")
+	u := opt.uploadEndpoint()
 
 	switch {
+	case strings.HasPrefix(filePath, s3Scheme):
+		// Upload image using Amazon S3
+		return us.uploadFromS3(ctx, u, filePath, opt)
+	case strings.HasPrefix(filePath, gsScheme):
+		// Upload image using Google Storage
+		return us.uploadFromGoogleStorage(ctx, u, filePath, opt)
 	case strings.Contains(filePath, "://"):
 		// Upload image using HTTPS URL or HTTP
 		return us.uploadFromURL(ctx, u, filePath, opt)
-	case strings.Contains(filePath, "s3"):
-		// Upload image using Amazon S3
-		//return us.uploadFromS3(ctx, u, request, opt)
-	case strings.Contains(filePath, "gs"):
-		// Upload image using Google Storage
-		//return us.uploadFromGoogleStorage(ctx, u, request, opt)
-
 	default:
 		return us.handleUploadFromLocalPath(ctx, u, filePath, opt)
 	}
-
-	return ur, r, err
 }
 
 // UnsignedUploadImage handle unsigned uploading image to Cloudinary.
@@ -108,25 +170,22 @@ func (us *UploadService) UnsignedUploadImage(ctx context.Context, filePath strin
 	opt.isUnsignedUpload = true
 	opt.UploadPreset = &uploadPreset
 
-	u := fmt.Sprintf("image/upload")
+	u := opt.uploadEndpoint()
 
 	switch {
 	case strings.HasPrefix(filePath, "/"):
 		// Upload image using local path
 		return us.handleUploadFromLocalPath(ctx, u, filePath, opt)
-	case strings.HasPrefix(filePath, "s3"):
+	case strings.HasPrefix(filePath, s3Scheme):
 		// Upload image using Amazon S3
-		//return us.uploadFromS3(ctx, u, request, opt)
-	case strings.HasPrefix(filePath, "gs"):
+		return us.uploadFromS3(ctx, u, filePath, opt)
+	case strings.HasPrefix(filePath, gsScheme):
 		// Upload image using Google Storage
-		//return us.uploadFromGoogleStorage(ctx, u, request, opt)
-
+		return us.uploadFromGoogleStorage(ctx, u, filePath, opt)
 	default:
 		// Upload image using HTTPS URL or HTTP
 		return us.uploadFromURL(ctx, u, filePath, opt)
 	}
-
-	return &UploadResponse{}, &Response{}, nil
 }
 
 func (us *UploadService) uploadFromURL(ctx context.Context, u, fileURL string, opts *Options) (ur *UploadResponse, resp *Response, err error) {
@@ -237,22 +296,114 @@ func (us *UploadService) handleUploadFromLocalPath(ctx context.Context, u, fileP
 	return ur, resp, nil
 }
 
-func (us *UploadService) uploadFromS3(ctx context.Context, url string, request *UploadRequest, opt *Options) (*UploadResponse, *Response, error) {
-	return &UploadResponse{}, &Response{}, nil
+// UploadReader handle signed uploading the content read from r to Cloudinary.
+// The multipart body is streamed through an io.Pipe while the request is sent,
+// so the asset is never held in memory as a whole.
+func (us *UploadService) UploadReader(ctx context.Context, r io.Reader, filename string, opts ...SetOpts) (ur *UploadResponse, resp *Response, err error) {
+	if r == nil {
+		return nil, nil, errors.New("invalid reader")
+	}
+	if strings.TrimSpace(filename) == "" {
+		return nil, nil, errors.New("invalid filename")
+	}
+	opt := new(Options)
+	for _, o := range opts {
+		o(opt)
+	}
+	opt.isUnsignedUpload = false
+
+	u := opt.uploadEndpoint()
+
+	return us.uploadFromReader(ctx, u, r, filename, opt)
 }
 
-func (us *UploadService) uploadFromGoogleStorage(ctx context.Context, url string, request *UploadRequest, opt *Options) (*UploadResponse, *Response, error) {
-	return &UploadResponse{}, &Response{}, nil
+func (us *UploadService) uploadFromReader(ctx context.Context, u string, r io.Reader, filename string, opts *Options) (ur *UploadResponse, resp *Response, err error) {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	writer := multipart.NewWriter(pw)
+
+	if !opts.isUnsignedUpload {
+		timestamp := fmt.Sprintf("%d", time.Now().UTC().Unix())
+		opts.Timestamp = &timestamp
+	}
+
+	go func() {
+		pw.CloseWithError(us.writeStreamBody(writer, r, filename, opts))
+	}()
+
+	req, err := us.client.NewUploadRequest(u, pr, writer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ur = new(UploadResponse)
+	resp, err = us.client.Do(ctx, req, ur)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ur, resp, nil
+}
+
+// writeStreamBody writes the whole multipart body for a streamed upload.
+// The parameters are written before the file part so that the signature
+// doesn't depend on the asset having been read.
+func (us *UploadService) writeStreamBody(writer *multipart.Writer, r io.Reader, filename string, opts *Options) error {
+	if !opts.isUnsignedUpload {
+		if err := writer.WriteField("api_key", us.client.apiKey); err != nil {
+			return err
+		}
+	}
+
+	if err := us.buildParamsFromOptions(opts, writer); err != nil {
+		return err
+	}
+
+	part, err := writer.CreateFormFile("file", filepath.Base(filename))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// uploadFromS3 uploads a file stored in an Amazon S3 bucket, e.g. s3://my-bucket/path/to/image.jpg
+// The bucket must grant Cloudinary access to read the file.
+//
+// Documentation: https://cloudinary.com/documentation/upload_images#upload_from_a_private_storage_url_amazon_s3_or_google_cloud
+func (us *UploadService) uploadFromS3(ctx context.Context, u, fileURL string, opt *Options) (*UploadResponse, *Response, error) {
+	if _, _, err := parseBucketURL(fileURL, s3Scheme, s3BucketPattern); err != nil {
+		return nil, nil, err
+	}
+	return us.uploadFromURL(ctx, u, fileURL, opt)
+}
+
+// uploadFromGoogleStorage uploads a file stored in a Google Cloud Storage bucket, e.g. gs://my-bucket/path/to/image.jpg
+// The bucket must grant Cloudinary access to read the file.
+func (us *UploadService) uploadFromGoogleStorage(ctx context.Context, u, fileURL string, opt *Options) (*UploadResponse, *Response, error) {
+	if _, _, err := parseBucketURL(fileURL, gsScheme, gsBucketPattern); err != nil {
+		return nil, nil, err
+	}
+	return us.uploadFromURL(ctx, u, fileURL, opt)
 }
 
 func (us *UploadService) buildParamsFromOptions(opts *Options, writer *multipart.Writer) error {
-	var optMap map[string]interface{}
-	optByte, _ := json.Marshal(opts)
-	err := json.Unmarshal(optByte, &optMap)
+	optMap, err := opts.toMap()
 	if err != nil {
 		return err
 	}
 
+	// The resource type is part of the upload URL,
+	// it's neither sent as a parameter nor signed
+	delete(optMap, "resource_type")
+
+	for field, val := range opts.extraParams {
+		optMap[field] = val
+	}
+
 	hash := sha1.New()
 	params := make([]string, 0)
 
@@ -269,10 +420,21 @@ func (us *UploadService) buildParamsFromOptions(opts *Options, writer *multipart
 	for i := range keys {
 		field := keys[i]
 
-		valStr := fmt.Sprintf("%v", optMap[field])
-		err := writer.WriteField(field, valStr)
-		if err != nil {
-			return err
+		var valStr string
+		switch val := optMap[field].(type) {
+		case []string:
+			// Arrays are sent as repeated `field[]` values and signed as a comma separated list
+			for _, v := range val {
+				if err := writer.WriteField(field+"[]", v); err != nil {
+					return err
+				}
+			}
+			valStr = strings.Join(val, ",")
+		default:
+			valStr = fmt.Sprintf("%v", val)
+			if err := writer.WriteField(field, valStr); err != nil {
+				return err
+			}
 		}
 
 		params = append(params, fmt.Sprintf("%s=%s", field, valStr))
@@ -295,6 +457,167 @@ func (us *UploadService) buildParamsFromOptions(opts *Options, writer *multipart
 	return nil
 }
 
+// Explicit applies actions to an already uploaded asset: generating eager transformations
+// with WithEager, re-running add-ons such as WithAutoTagging or WithOCR,
+// or updating its tags, context, face and custom coordinates
+//
+// Documentation: https://cloudinary.com/documentation/image_upload_api_reference#explicit_method
+func (us *UploadService) Explicit(ctx context.Context, publicId string, opts ...SetOpts) (ur *UploadResponse, resp *Response, err error) {
+	if strings.TrimSpace(publicId) == "" {
+		return nil, nil, errors.New("invalid public id")
+	}
+	opt := new(Options)
+	for _, o := range opts {
+		o(opt)
+	}
+	opt.isUnsignedUpload = false
+	opt.PublicId = &publicId
+	if opt.Type == nil {
+		storageType := "upload"
+		opt.Type = &storageType
+	}
+
+	u := fmt.Sprintf("%s/explicit", opt.uploadResourceType())
+
+	ur = new(UploadResponse)
+	resp, err = us.postSigned(ctx, u, opt, ur)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ur, resp, nil
+}
+
+// DestroyResponse is the result of Destroy, Result is either "ok" or "not found"
+type DestroyResponse struct {
+	Result string `json:"result"`
+}
+
+// Destroy deletes a single asset, its derived assets are deleted too.
+// WithInvalidate(true) also invalidates the CDN cached copies of the asset.
+//
+// Documentation: https://cloudinary.com/documentation/image_upload_api_reference#destroy_method
+func (us *UploadService) Destroy(ctx context.Context, publicId string, opts ...SetOpts) (dr *DestroyResponse, resp *Response, err error) {
+	if strings.TrimSpace(publicId) == "" {
+		return nil, nil, errors.New("invalid public id")
+	}
+	opt := new(Options)
+	for _, o := range opts {
+		o(opt)
+	}
+	opt.isUnsignedUpload = false
+	opt.PublicId = &publicId
+
+	u := fmt.Sprintf("%s/destroy", opt.uploadResourceType())
+
+	dr = new(DestroyResponse)
+	resp, err = us.postSigned(ctx, u, opt, dr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dr, resp, nil
+}
+
+// DeleteByToken deletes an asset with the DeleteToken returned by an upload with
+// WithReturnDeleteToken(true). The token is valid for 10 minutes after the upload,
+// the request is neither authenticated nor signed so it can be sent from clients.
+//
+// Documentation: https://cloudinary.com/documentation/image_upload_api_reference#delete_by_token_method
+func (us *UploadService) DeleteByToken(ctx context.Context, token string) (dr *DestroyResponse, resp *Response, err error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if err := writer.WriteField("token", token); err != nil {
+		return nil, nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := us.client.NewUploadRequest(fmt.Sprintf("%s/delete_by_token", ResourceTypeImage), body, writer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dr = new(DestroyResponse)
+	resp, err = us.client.Do(ctx, req, dr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dr, resp, nil
+}
+
+// Rename changes the public ID of an asset from fromPublicId to toPublicId.
+// WithOverwrite(true) replaces an existing asset with the new public ID,
+// WithToType changes the storage type of the asset and WithInvalidate(true)
+// invalidates the CDN cached copies of the asset.
+//
+// Documentation: https://cloudinary.com/documentation/image_upload_api_reference#rename_method
+func (us *UploadService) Rename(ctx context.Context, fromPublicId, toPublicId string, opts ...SetOpts) (ur *UploadResponse, resp *Response, err error) {
+	if strings.TrimSpace(fromPublicId) == "" || strings.TrimSpace(toPublicId) == "" {
+		return nil, nil, errors.New("invalid public id")
+	}
+	opt := new(Options)
+	for _, o := range opts {
+		o(opt)
+	}
+	opt.isUnsignedUpload = false
+	opt.PublicId = nil
+	opt.setExtraParam("from_public_id", fromPublicId)
+	opt.setExtraParam("to_public_id", toPublicId)
+
+	u := fmt.Sprintf("%s/rename", opt.uploadResourceType())
+
+	ur = new(UploadResponse)
+	resp, err = us.postSigned(ctx, u, opt, ur)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ur, resp, nil
+}
+
+// postSigned sends the options as a signed multipart request that has no file,
+// the response is decoded into v
+func (us *UploadService) postSigned(ctx context.Context, u string, opts *Options, v interface{}) (*Response, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	timestamp := fmt.Sprintf("%d", time.Now().UTC().Unix())
+	opts.Timestamp = &timestamp
+
+	if err := writer.WriteField("api_key", us.client.apiKey); err != nil {
+		return nil, err
+	}
+
+	if err := us.buildParamsFromOptions(opts, writer); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := us.client.NewUploadRequest(u, body, writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return us.client.Do(ctx, req, v)
+}
+
+// withForcedResourceType returns a copy of opts that always ends with the given resource type
+func withForcedResourceType(opts []SetOpts, resourceType ResourceType) []SetOpts {
+	forced := make([]SetOpts, 0, len(opts)+1)
+	forced = append(forced, opts...)
+	return append(forced, WithResourceType(string(resourceType)))
+}
+
 func (us *UploadService) openFile(filePath string) (file *os.File, dir string, err error) {
 	dir, err = os.Getwd()
 	if err != nil {
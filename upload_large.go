@@ -0,0 +1,160 @@
+package cloudinary
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultChunkSize is the chunk size used by UploadLarge when WithChunkSize isn't given.
+	defaultChunkSize int64 = 20 * 1024 * 1024
+	// minChunkSize is the smallest chunk accepted by Cloudinary, only the last chunk may be smaller
+	minChunkSize int64 = 5 * 1024 * 1024
+)
+
+// LargeUploadError is returned by UploadLarge when one of the chunks can't be uploaded.
+// The session can be resumed by calling UploadLarge again
+// with WithUniqueUploadId(UniqueUploadId) and WithUploadOffset(Offset).
+type LargeUploadError struct {
+	UniqueUploadId string
+	Offset         int64 // The first byte that hasn't been acknowledged by Cloudinary
+	Err            error
+}
+
+func (e *LargeUploadError) Error() string {
+	return fmt.Sprintf("upload_large %s failed at byte %d: %v", e.UniqueUploadId, e.Offset, e.Err)
+}
+
+func (e *LargeUploadError) Unwrap() error {
+	return e.Err
+}
+
+// UploadLarge handle signed uploading of files that are larger than the single request limit.
+// The content of r is split in chunks that are sent in order with the `Content-Range`
+// and `X-Unique-Upload-Id` headers, the UploadResponse is returned after the last chunk.
+//
+// Documentation: https://cloudinary.com/documentation/upload_images#chunked_asset_upload
+func (us *UploadService) UploadLarge(ctx context.Context, r io.ReaderAt, size int64, filename string, opts ...SetOpts) (ur *UploadResponse, resp *Response, err error) {
+	if r == nil {
+		return nil, nil, errors.New("invalid reader")
+	}
+	if size <= 0 {
+		return nil, nil, errors.New("invalid size")
+	}
+	if strings.TrimSpace(filename) == "" {
+		return nil, nil, errors.New("invalid filename")
+	}
+	opt := new(Options)
+	for _, o := range opts {
+		o(opt)
+	}
+	opt.isUnsignedUpload = false
+
+	chunkSize := opt.GetChunkSize()
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	if chunkSize < minChunkSize && chunkSize < size {
+		return nil, nil, errors.New("chunk size must be at least 5MB")
+	}
+
+	offset := opt.GetUploadOffset()
+	if offset < 0 || offset >= size {
+		return nil, nil, errors.New("invalid upload offset")
+	}
+
+	uploadId := opt.GetUniqueUploadId()
+	if uploadId == "" {
+		// A session can only be resumed with the upload ID that started it
+		if offset > 0 {
+			return nil, nil, errors.New("upload offset requires the unique upload id of the session")
+		}
+		uploadId, err = newUniqueUploadId()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	u := opt.uploadEndpoint()
+
+	for offset < size {
+		end := offset + chunkSize
+		if end > size {
+			end = size
+		}
+
+		chunk := io.NewSectionReader(r, offset, end-offset)
+		ur, resp, err = us.uploadChunk(ctx, u, chunk, filename, uploadId, offset, end, size, opt)
+		if err != nil {
+			return nil, resp, &LargeUploadError{UniqueUploadId: uploadId, Offset: offset, Err: err}
+		}
+
+		offset = end
+	}
+
+	return ur, resp, nil
+}
+
+// uploadChunk sends the bytes [start, end) of a file of the given size.
+// Each chunk is a complete signed upload request on its own.
+func (us *UploadService) uploadChunk(ctx context.Context, u string, chunk io.Reader, filename, uploadId string, start, end, size int64, opts *Options) (ur *UploadResponse, resp *Response, err error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	// The timestamp is refreshed for every chunk so that resumed sessions
+	// don't send an expired signature
+	timestamp := fmt.Sprintf("%d", time.Now().UTC().Unix())
+	opts.Timestamp = &timestamp
+
+	if err := writer.WriteField("api_key", us.client.apiKey); err != nil {
+		return nil, nil, err
+	}
+
+	if err := us.buildParamsFromOptions(opts, writer); err != nil {
+		return nil, nil, err
+	}
+
+	part, err := writer.CreateFormFile("file", filepath.Base(filename))
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := io.Copy(part, chunk); err != nil {
+		return nil, nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := us.client.NewUploadRequest(u, body, writer)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("X-Unique-Upload-Id", uploadId)
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, size))
+
+	ur = new(UploadResponse)
+	resp, err = us.client.Do(ctx, req, ur)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ur, resp, nil
+}
+
+func newUniqueUploadId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,178 @@
+package cloudinary
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// chunkServer is a stand-in of the chunked upload endpoint, it rejects the chunks
+// that don't continue their session and fails the chunk starting at failAt once
+type chunkServer struct {
+	t      *testing.T
+	size   int64
+	failAt int64
+
+	mu       sync.Mutex
+	received map[string]*bytes.Buffer
+	ranges   []string
+	failed   bool
+}
+
+func (s *chunkServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.URL.Path != "/v1_1/demo/image/upload" {
+		s.t.Errorf("request path = %s", r.URL.Path)
+	}
+	uploadId := r.Header.Get("X-Unique-Upload-Id")
+	contentRange := r.Header.Get("Content-Range")
+	s.ranges = append(s.ranges, uploadId+" "+contentRange)
+
+	var start, end, size int64
+	if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &start, &end, &size); err != nil || size != s.size {
+		http.Error(w, `{"error":{"message":"invalid Content-Range"}}`, http.StatusBadRequest)
+		return
+	}
+
+	if s.received == nil {
+		s.received = make(map[string]*bytes.Buffer)
+	}
+	buf, ok := s.received[uploadId]
+	if !ok {
+		buf = new(bytes.Buffer)
+		s.received[uploadId] = buf
+	}
+	if start != int64(buf.Len()) {
+		http.Error(w, `{"error":{"message":"chunk out of order"}}`, http.StatusBadRequest)
+		return
+	}
+	if start == s.failAt && !s.failed {
+		s.failed = true
+		http.Error(w, `{"error":{"message":"unavailable"}}`, http.StatusServiceUnavailable)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		s.t.Error(err)
+		http.Error(w, `{"error":{"message":"missing file"}}`, http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	n, _ := io.Copy(buf, file)
+	if n != end-start+1 {
+		http.Error(w, `{"error":{"message":"invalid chunk length"}}`, http.StatusBadRequest)
+		return
+	}
+
+	if end+1 == size {
+		fmt.Fprint(w, `{"public_id":"large","bytes":`+fmt.Sprint(size)+`}`)
+		return
+	}
+	fmt.Fprint(w, `{"done":false}`)
+}
+
+func largeFile(size int) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = byte(i % 251)
+	}
+	return b
+}
+
+func TestUploadLarge(t *testing.T) {
+	data := largeFile(12 * 1024 * 1024)
+	s := &chunkServer{t: t, size: int64(len(data)), failAt: -1}
+	c := setup(t, s.ServeHTTP)
+
+	ur, _, err := c.Upload.UploadLarge(context.Background(), bytes.NewReader(data), int64(len(data)), "large.bin",
+		WithChunkSize(minChunkSize), WithUniqueUploadId("id1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ur.PublicId != "large" {
+		t.Errorf("PublicId = %q", ur.PublicId)
+	}
+
+	want := []string{
+		"id1 bytes 0-5242879/12582912",
+		"id1 bytes 5242880-10485759/12582912",
+		"id1 bytes 10485760-12582911/12582912",
+	}
+	if fmt.Sprint(s.ranges) != fmt.Sprint(want) {
+		t.Errorf("ranges = %v, want %v", s.ranges, want)
+	}
+	if !bytes.Equal(s.received["id1"].Bytes(), data) {
+		t.Error("the uploaded content differs from the file")
+	}
+}
+
+func TestUploadLargeResume(t *testing.T) {
+	data := largeFile(11 * 1024 * 1024)
+	s := &chunkServer{t: t, size: int64(len(data)), failAt: minChunkSize}
+	c := setup(t, s.ServeHTTP)
+
+	_, _, err := c.Upload.UploadLarge(context.Background(), bytes.NewReader(data), int64(len(data)), "large.bin",
+		WithChunkSize(minChunkSize))
+	var luErr *LargeUploadError
+	if !errors.As(err, &luErr) {
+		t.Fatalf("err = %v, want a LargeUploadError", err)
+	}
+	if luErr.Offset != minChunkSize || luErr.UniqueUploadId == "" {
+		t.Fatalf("LargeUploadError = %+v", luErr)
+	}
+
+	ur, _, err := c.Upload.UploadLarge(context.Background(), bytes.NewReader(data), int64(len(data)), "large.bin",
+		WithChunkSize(minChunkSize), WithUniqueUploadId(luErr.UniqueUploadId), WithUploadOffset(luErr.Offset))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ur.PublicId != "large" {
+		t.Errorf("PublicId = %q", ur.PublicId)
+	}
+
+	id := luErr.UniqueUploadId
+	want := []string{
+		id + " bytes 0-5242879/11534336",
+		id + " bytes 5242880-10485759/11534336",
+		id + " bytes 5242880-10485759/11534336",
+		id + " bytes 10485760-11534335/11534336",
+	}
+	if fmt.Sprint(s.ranges) != fmt.Sprint(want) {
+		t.Errorf("ranges = %v, want %v", s.ranges, want)
+	}
+	if len(s.received) != 1 || !bytes.Equal(s.received[id].Bytes(), data) {
+		t.Error("the uploaded content differs from the file")
+	}
+}
+
+func TestUploadLargeInvalidOptions(t *testing.T) {
+	c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+	data := largeFile(10)
+
+	tests := []struct {
+		name string
+		opts []SetOpts
+	}{
+		{"offset without upload id", []SetOpts{WithChunkSize(minChunkSize), WithUploadOffset(4)}},
+		{"offset past the end", []SetOpts{WithUniqueUploadId("id1"), WithUploadOffset(10)}},
+		{"chunk size under 5MB", []SetOpts{WithChunkSize(4)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := c.Upload.UploadLarge(context.Background(), bytes.NewReader(data), int64(len(data)), "small.bin", tt.opts...)
+			if err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
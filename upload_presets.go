@@ -0,0 +1,151 @@
+package cloudinary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// UploadPreset is a named set of upload options, as created or updated.
+// Its settings are the same Options used for uploading, the presets are read as UploadPresetDetails.
+//
+// Documentation: https://cloudinary.com/documentation/admin_api#upload_presets
+type UploadPreset struct {
+	Name     string  `json:"name"`
+	Unsigned bool    `json:"unsigned"`
+	Settings Options `json:"settings"`
+}
+
+// NewUploadPreset returns an UploadPreset whose settings are set with the upload options,
+// e.g. NewUploadPreset("avatars", true, WithFolder("avatars"), WithTags("avatar"))
+func NewUploadPreset(name string, unsigned bool, opts ...SetOpts) UploadPreset {
+	preset := UploadPreset{Name: name, Unsigned: unsigned}
+	for _, setOpt := range opts {
+		setOpt(&preset.Settings)
+	}
+	return preset
+}
+
+// UploadPresetDetails is an upload preset returned by the Admin API.
+// Its settings are kept as returned, since some of them don't have the type of the upload options:
+// tags is a list, context an object and transformation a list of objects.
+type UploadPresetDetails struct {
+	Name     string                 `json:"name"`
+	Unsigned bool                   `json:"unsigned"`
+	Settings map[string]interface{} `json:"settings"`
+}
+
+type UploadPresetResponse struct {
+	Message string `json:"message"`
+	Name    string `json:"name"`
+}
+
+type ListUploadPresetsResponse struct {
+	Presets    []UploadPresetDetails `json:"presets"`
+	NextCursor string                `json:"next_cursor"`
+}
+
+// CreateUploadPreset creates an upload preset, its name is generated when it's empty
+func (as *AdminService) CreateUploadPreset(ctx context.Context, preset UploadPreset) (up *UploadPresetResponse, resp *Response, err error) {
+	body, err := uploadPresetBody(preset)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	up = new(UploadPresetResponse)
+	resp, err = as.send(ctx, "POST", "upload_presets", body, up)
+	if err != nil {
+		return nil, resp, err
+	}
+	return up, resp, nil
+}
+
+// UpdateUploadPreset replaces the settings of the upload preset with the same name
+func (as *AdminService) UpdateUploadPreset(ctx context.Context, preset UploadPreset) (up *UploadPresetResponse, resp *Response, err error) {
+	if preset.Name == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+	body, err := uploadPresetBody(preset)
+	if err != nil {
+		return nil, nil, err
+	}
+	delete(body, "name")
+
+	up = new(UploadPresetResponse)
+	resp, err = as.send(ctx, "PUT", uploadPresetPath(preset.Name), body, up)
+	if err != nil {
+		return nil, resp, err
+	}
+	return up, resp, nil
+}
+
+// GetUploadPreset returns the upload preset with the given name
+func (as *AdminService) GetUploadPreset(ctx context.Context, name string) (up *UploadPresetDetails, resp *Response, err error) {
+	if name == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	up = new(UploadPresetDetails)
+	resp, err = as.send(ctx, "GET", uploadPresetPath(name), nil, up)
+	if err != nil {
+		return nil, resp, err
+	}
+	return up, resp, nil
+}
+
+// ListUploadPresets lists the upload presets, paged with WithMaxResults and WithNextCursor
+func (as *AdminService) ListUploadPresets(ctx context.Context, opts ...SetOpts) (lp *ListUploadPresetsResponse, resp *Response, err error) {
+	o := new(Options)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	u := as.buildURLStrWithParams("upload_presets", as.listParams(o))
+
+	lp = new(ListUploadPresetsResponse)
+	resp, err = as.send(ctx, "GET", u, nil, lp)
+	if err != nil {
+		return nil, resp, err
+	}
+	return lp, resp, nil
+}
+
+// DeleteUploadPreset deletes the upload preset with the given name
+func (as *AdminService) DeleteUploadPreset(ctx context.Context, name string) (up *UploadPresetResponse, resp *Response, err error) {
+	if name == "" {
+		return nil, nil, errors.New("invalid parameter")
+	}
+
+	up = new(UploadPresetResponse)
+	resp, err = as.send(ctx, "DELETE", uploadPresetPath(name), nil, up)
+	if err != nil {
+		return nil, resp, err
+	}
+	return up, resp, nil
+}
+
+// uploadPresetBody returns the request body of an upload preset,
+// the Admin API expects the settings next to the name
+func uploadPresetBody(preset UploadPreset) (map[string]interface{}, error) {
+	body, err := preset.Settings.toMap()
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		body = make(map[string]interface{})
+	}
+	delete(body, "resource_type")
+	delete(body, "timestamp")
+
+	if preset.Name != "" {
+		body["name"] = preset.Name
+	}
+	body["unsigned"] = preset.Unsigned
+
+	return body, nil
+}
+
+func uploadPresetPath(name string) string {
+	return fmt.Sprintf("upload_presets/%s", url.PathEscape(name))
+}
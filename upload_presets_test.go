@@ -0,0 +1,61 @@
+package cloudinary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+const testUploadPreset = `{"name":"avatars","unsigned":true,"settings":{"folder":"avatars","overwrite":true,` +
+	`"tags":["a","b"],"context":{"a":"b"},"transformation":[{"width":100,"crop":"scale"}]}}`
+
+func TestGetUploadPreset(t *testing.T) {
+	c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v1_1/demo/upload_presets/avatars" {
+			t.Errorf("request = %s %s", r.Method, r.URL)
+		}
+		fmt.Fprint(w, testUploadPreset)
+	})
+
+	up, _, err := c.Admin.GetUploadPreset(context.Background(), "avatars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkUploadPreset(t, up)
+}
+
+func TestListUploadPresets(t *testing.T) {
+	c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v1_1/demo/upload_presets" || r.URL.Query().Get("max_results") != "10" {
+			t.Errorf("request = %s %s", r.Method, r.URL)
+		}
+		fmt.Fprint(w, `{"presets":[`+testUploadPreset+`,{"name":"empty","unsigned":false,"settings":{}}],"next_cursor":"c1"}`)
+	})
+
+	lp, _, err := c.Admin.ListUploadPresets(context.Background(), WithMaxResults(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lp.Presets) != 2 || lp.NextCursor != "c1" {
+		t.Fatalf("response = %+v", lp)
+	}
+	checkUploadPreset(t, &lp.Presets[0])
+}
+
+func checkUploadPreset(t *testing.T, up *UploadPresetDetails) {
+	t.Helper()
+
+	if up.Name != "avatars" || !up.Unsigned || up.Settings["folder"] != "avatars" || up.Settings["overwrite"] != true {
+		t.Errorf("preset = %+v", up)
+	}
+	if got := fmt.Sprint(up.Settings["tags"]); got != "[a b]" {
+		t.Errorf("tags = %s, want [a b]", got)
+	}
+	if got := fmt.Sprint(up.Settings["context"]); got != "map[a:b]" {
+		t.Errorf("context = %s, want map[a:b]", got)
+	}
+	if got := fmt.Sprint(up.Settings["transformation"]); got != "[map[crop:scale width:100]]" {
+		t.Errorf("transformation = %s, want [map[crop:scale width:100]]", got)
+	}
+}
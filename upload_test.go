@@ -0,0 +1,76 @@
+package cloudinary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUploadRouting(t *testing.T) {
+	// Local paths are relative to the working directory
+	tmp, err := os.MkdirTemp(".", "upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(tmp) })
+	dir := "/" + tmp
+
+	for _, name := range []string{"logs3.png", "gs/image.png"} {
+		path := filepath.Join(tmp, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("png"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		wantURL  string // The file field sent as a URL, empty when the file is sent as content
+	}{
+		{"local path containing s3", filepath.Join(dir, "logs3.png"), ""},
+		{"local path containing gs", filepath.Join(dir, "gs/image.png"), ""},
+		{"s3 bucket", "s3://my-bucket/image.png", "s3://my-bucket/image.png"},
+		{"gs bucket", "gs://my-bucket/image.png", "gs://my-bucket/image.png"},
+		{"remote URL", "https://example.com/s3/image.png", "https://example.com/s3/image.png"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseMultipartForm(1 << 20); err != nil {
+					t.Fatal(err)
+				}
+				urls := r.MultipartForm.Value["file"]
+				files := r.MultipartForm.File["file"]
+				switch {
+				case tt.wantURL == "" && (len(urls) != 0 || len(files) != 1):
+					t.Errorf("file sent as %v, want the content of %s", urls, tt.filePath)
+				case tt.wantURL != "" && (len(urls) != 1 || urls[0] != tt.wantURL):
+					t.Errorf("file sent as %v, want %s", urls, tt.wantURL)
+				}
+				fmt.Fprint(w, `{"public_id":"image"}`)
+			})
+
+			if _, _, err := c.Upload.UploadImage(context.Background(), tt.filePath); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestUploadInvalidBucketURL(t *testing.T) {
+	c := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+
+	for _, filePath := range []string{"s3://Bad_Bucket/image.png", "s3://my-bucket", "gs://my..bucket/image.png"} {
+		if _, _, err := c.Upload.UploadImage(context.Background(), filePath); err == nil {
+			t.Errorf("UploadImage(%q) expected an error", filePath)
+		}
+	}
+}
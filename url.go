@@ -0,0 +1,293 @@
+package cloudinary
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const (
+	defaultDeliveryHost = "res.cloudinary.com"
+
+	// Length of the URL signature, in base64 characters
+	shortURLSignatureLength = 8
+	longURLSignatureLength  = 32
+)
+
+// versionPattern matches a public ID that already starts with a version component, e.g. v1/folder/image
+var versionPattern = regexp.MustCompile(`^v[0-9]+/`)
+
+// URLOptions are the options used to build a delivery URL
+type URLOptions struct {
+	Secure         *bool
+	Version        *int64
+	Format         *string
+	ResourceType   *string
+	Type           *string
+	Transformation *Transformation
+
+	// SignURL adds the s--SIGNATURE-- component required to deliver
+	// authenticated and private assets, or to allow strict transformations
+	SignURL *bool
+	// LongURLSignature uses the 32 characters SHA-256 signature
+	// instead of the 8 characters SHA-1 one
+	LongURLSignature *bool
+	// AuthToken appends a __cld_token__ to the URL, it replaces the s--SIGNATURE-- component
+	AuthToken *AuthToken
+}
+
+type URLOption func(o *URLOptions)
+
+// WithURLSecure sets whether the URL uses https, the default is true
+func WithURLSecure(secure bool) URLOption {
+	return func(o *URLOptions) {
+		o.Secure = &secure
+	}
+}
+
+func WithURLVersion(version int64) URLOption {
+	return func(o *URLOptions) {
+		o.Version = &version
+	}
+}
+
+func WithURLFormat(format string) URLOption {
+	return func(o *URLOptions) {
+		o.Format = &format
+	}
+}
+
+func WithURLResourceType(resourceType string) URLOption {
+	return func(o *URLOptions) {
+		o.ResourceType = &resourceType
+	}
+}
+
+// WithURLType sets the storage type of the asset, e.g. upload, private, authenticated or fetch
+func WithURLType(typeStr string) URLOption {
+	return func(o *URLOptions) {
+		o.Type = &typeStr
+	}
+}
+
+func WithURLTransformation(t Transformation) URLOption {
+	return func(o *URLOptions) {
+		o.Transformation = &t
+	}
+}
+
+func WithSignURL(sign bool) URLOption {
+	return func(o *URLOptions) {
+		o.SignURL = &sign
+	}
+}
+
+func WithLongURLSignature(long bool) URLOption {
+	return func(o *URLOptions) {
+		o.LongURLSignature = &long
+	}
+}
+
+// WithAuthToken authenticates the URL with a token generated from t.
+// The URL path is used as the token URL when t has no ACL.
+func WithAuthToken(t AuthToken) URLOption {
+	return func(o *URLOptions) {
+		o.AuthToken = &t
+	}
+}
+
+func (o *URLOptions) GetSecure() bool {
+	if o.Secure != nil {
+		return *o.Secure
+	}
+	return true
+}
+
+func (o *URLOptions) GetVersion() int64 {
+	if o.Version != nil {
+		return *o.Version
+	}
+	return 0
+}
+
+// GetFormat returns the format of the URL, falling back to the format of the transformation
+func (o *URLOptions) GetFormat() string {
+	if o.Format != nil {
+		return *o.Format
+	}
+	if o.Transformation != nil {
+		return o.Transformation.format
+	}
+	return ""
+}
+
+func (o *URLOptions) GetResourceType() string {
+	if o.ResourceType != nil {
+		return *o.ResourceType
+	}
+	return string(ResourceTypeImage)
+}
+
+func (o *URLOptions) GetType() string {
+	if o.Type != nil {
+		return *o.Type
+	}
+	return "upload"
+}
+
+func (o *URLOptions) GetTransformation() string {
+	if o.Transformation != nil {
+		return o.Transformation.String()
+	}
+	return ""
+}
+
+func (o *URLOptions) GetSignURL() bool {
+	if o.SignURL != nil {
+		return *o.SignURL
+	}
+	return false
+}
+
+func (o *URLOptions) GetLongURLSignature() bool {
+	if o.LongURLSignature != nil {
+		return *o.LongURLSignature
+	}
+	return false
+}
+
+// URL returns the delivery URL of the asset with the given public ID:
+// https://res.cloudinary.com/<cloud_name>/<resource_type>/<type>/<transformations>/v<version>/<public_id>.<format>
+// The host is the one configured with WithSecureDistribution, WithCNAME or WithPrivateCDN, if any.
+//
+// Like the other Cloudinary SDKs, v1 is used as version when the public ID
+// contains a folder and no version is given, so that the folder isn't
+// mistaken for a transformation.
+//
+// Documentation: https://cloudinary.com/documentation/image_transformations#delivery_url_structure
+func (c *Client) URL(publicId string, opts ...URLOption) (string, error) {
+	if strings.TrimSpace(publicId) == "" {
+		return "", errors.New("invalid public id")
+	}
+	if c.cloudName == "" {
+		return "", errors.New("cloud name is required to build a delivery URL")
+	}
+
+	o := new(URLOptions)
+	for _, setOpt := range opts {
+		setOpt(o)
+	}
+
+	scheme := "https"
+	host := c.secureDistribution
+	if !o.GetSecure() {
+		scheme = "http"
+		host = c.cname
+	}
+	if host == "" {
+		host = c.deliveryHost()
+	}
+
+	path := "/" + c.deliveryPath(publicId, o)
+	if !c.privateCDN {
+		// The shared delivery host serves every cloud, it's told apart by the path
+		path = "/" + c.cloudName + path
+	}
+	u := fmt.Sprintf("%s://%s%s", scheme, host, path)
+
+	if o.AuthToken != nil {
+		token := *o.AuthToken
+		if len(token.ACL) == 0 {
+			token.URL = path
+		}
+		t, err := token.Generate()
+		if err != nil {
+			return "", err
+		}
+		u += "?" + t
+	}
+
+	return u, nil
+}
+
+// deliveryHost returns the default delivery host, the private CDN host of the cloud is used when enabled
+func (c *Client) deliveryHost() string {
+	if c.privateCDN {
+		return c.cloudName + "-" + defaultDeliveryHost
+	}
+	return defaultDeliveryHost
+}
+
+// deliveryPath returns the part of the delivery URL that follows the cloud name
+func (c *Client) deliveryPath(publicId string, o *URLOptions) string {
+	segments := []string{o.GetResourceType(), o.GetType()}
+
+	transformation := o.GetTransformation()
+	format := o.GetFormat()
+
+	if o.GetSignURL() && o.AuthToken == nil {
+		source := publicId
+		if format != "" {
+			source += "." + format
+		}
+		segments = append(segments, c.signURL(transformation, source, o.GetLongURLSignature()))
+	}
+
+	if transformation != "" {
+		segments = append(segments, transformation)
+	}
+
+	if version := o.GetVersion(); version > 0 {
+		segments = append(segments, fmt.Sprintf("v%d", version))
+	} else if strings.Contains(publicId, "/") && !versionPattern.MatchString(publicId) &&
+		!strings.Contains(publicId, "://") {
+		segments = append(segments, "v1")
+	}
+
+	source := escapePublicId(publicId)
+	if format != "" {
+		source += "." + format
+	}
+
+	return strings.Join(append(segments, source), "/")
+}
+
+// signURL returns the s--SIGNATURE-- component of a delivery URL.
+// The signature covers the transformation and the unescaped public ID with its format,
+// the version isn't signed.
+//
+// Documentation: https://cloudinary.com/documentation/control_access_to_media#signed_delivery_urls
+func (c *Client) signURL(transformation, source string, long bool) string {
+	toSign := source
+	if transformation != "" {
+		toSign = transformation + "/" + source
+	}
+
+	var digest []byte
+	length := shortURLSignatureLength
+	if long {
+		sum := sha256.Sum256([]byte(toSign + c.apiSecret))
+		digest = sum[:]
+		length = longURLSignatureLength
+	} else {
+		sum := sha1.Sum([]byte(toSign + c.apiSecret))
+		digest = sum[:]
+	}
+
+	signature := base64.URLEncoding.EncodeToString(digest)
+	return "s--" + signature[:length] + "--"
+}
+
+// escapePublicId escapes every segment of the public ID while keeping its folders
+func escapePublicId(publicId string) string {
+	parts := strings.Split(publicId, "/")
+	for i := range parts {
+		parts[i] = url.PathEscape(parts[i])
+	}
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,116 @@
+package cloudinary
+
+import (
+	"testing"
+	"time"
+)
+
+// The expected URLs are the ones generated by the reference Cloudinary SDKs
+// for the test123 cloud with the API secret "b"
+func TestURLSignature(t *testing.T) {
+	c, err := NewClient(nil, "cloudinary://a:b@test123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	crop := NewTransformation().Crop("crop").Width(10).Height(20)
+
+	tests := []struct {
+		name     string
+		publicId string
+		opts     []URLOption
+		want     string
+	}{
+		{
+			name:     "short signature with transformation",
+			publicId: "image",
+			opts:     []URLOption{WithURLFormat("jpg"), WithURLVersion(1234), WithSignURL(true), WithURLTransformation(crop)},
+			want:     "https://res.cloudinary.com/test123/image/upload/s--Ai4Znfl3--/c_crop,h_20,w_10/v1234/image.jpg",
+		},
+		{
+			name:     "short signature without transformation",
+			publicId: "image",
+			opts:     []URLOption{WithURLFormat("jpg"), WithURLVersion(1234), WithSignURL(true)},
+			want:     "https://res.cloudinary.com/test123/image/upload/s----SjmNDA--/v1234/image.jpg",
+		},
+		{
+			name:     "short signature without version",
+			publicId: "image",
+			opts:     []URLOption{WithURLFormat("jpg"), WithSignURL(true), WithURLTransformation(crop)},
+			want:     "https://res.cloudinary.com/test123/image/upload/s--Ai4Znfl3--/c_crop,h_20,w_10/image.jpg",
+		},
+		{
+			name:     "short signature",
+			publicId: "sample",
+			opts:     []URLOption{WithURLFormat("jpg"), WithSignURL(true)},
+			want:     "https://res.cloudinary.com/test123/image/upload/s--v2fTPYTu--/sample.jpg",
+		},
+		{
+			name:     "long signature",
+			publicId: "sample",
+			opts:     []URLOption{WithURLFormat("jpg"), WithSignURL(true), WithLongURLSignature(true)},
+			want:     "https://res.cloudinary.com/test123/image/upload/s--2hbrSMPOjj5BJ4xV7SgFbRDevFaQNUFf--/sample.jpg",
+		},
+		{
+			// The default v1 version isn't signed, the signature is the one of folder/sample.jpg
+			name:     "public id in a folder",
+			publicId: "folder/sample",
+			opts:     []URLOption{WithURLFormat("jpg"), WithSignURL(true)},
+			want:     "https://res.cloudinary.com/test123/image/upload/s--czlKab3H--/v1/folder/sample.jpg",
+		},
+		{
+			name:     "unsigned public id in a folder",
+			publicId: "folder/sample",
+			opts:     []URLOption{WithURLFormat("jpg"), WithURLSecure(false)},
+			want:     "http://res.cloudinary.com/test123/image/upload/v1/folder/sample.jpg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.URL(tt.publicId, tt.opts...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("URL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURLAuthToken(t *testing.T) {
+	c, err := NewClient(nil, "cloudinary://a:b@test123", WithPrivateCDN(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := AuthToken{Key: "00112233FF99", StartTime: time.Unix(11111111, 0), Duration: 300 * time.Second}
+
+	// The token replaces the s--SIGNATURE-- component of signed URLs
+	got, err := c.URL("sample", WithURLSecure(false), WithURLFormat("jpg"), WithURLType("authenticated"),
+		WithURLVersion(1486020273), WithSignURL(true), WithAuthToken(token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "http://test123-res.cloudinary.com/image/authenticated/v1486020273/sample.jpg" +
+		"?__cld_token__=st=11111111~exp=11111411~hmac=8db0d753ee7bbb9e2eaf8698ca3797436ba4c20e31f44527e43b6a6e995cfdb3"
+	if got != want {
+		t.Errorf("URL() = %s, want %s", got, want)
+	}
+}
+
+func TestAuthTokenGenerate(t *testing.T) {
+	token := AuthToken{
+		Key:       "00112233FF99",
+		StartTime: time.Unix(1111111111, 0),
+		Duration:  300 * time.Second,
+		ACL:       []string{"/image/*"},
+	}
+	got, err := token.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "__cld_token__=st=1111111111~exp=1111111411~acl=%2fimage%2f*" +
+		"~hmac=1751370bcc6cfe9e03f30dd1a9722ba0f2cdca283fa3e6df3342a00a7528cc51"
+	if got != want {
+		t.Errorf("Generate() = %s, want %s", got, want)
+	}
+}
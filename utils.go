@@ -1,7 +1,48 @@
 package cloudinary
 
-import "encoding/base64"
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	s3Scheme = "s3://"
+	gsScheme = "gs://"
+)
+
+var (
+	// https://docs.aws.amazon.com/AmazonS3/latest/userguide/bucketnamingrules.html
+	s3BucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	// https://cloud.google.com/storage/docs/buckets#naming
+	gsBucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`)
+)
 
 func getBase64EncodedString(u string, p string) string {
 	return base64.StdEncoding.EncodeToString([]byte(u + ":" + p))
 }
+
+// parseBucketURL splits a storage URL such as s3://bucket/key into its bucket and key,
+// the bucket must match the naming rules of the storage and the key can't be empty
+func parseBucketURL(fileURL, scheme string, bucketPattern *regexp.Regexp) (bucket, key string, err error) {
+	if !strings.HasPrefix(fileURL, scheme) {
+		return "", "", fmt.Errorf("%q is not a %s URL", fileURL, scheme)
+	}
+
+	path := strings.TrimPrefix(fileURL, scheme)
+	i := strings.Index(path, "/")
+	if i < 0 {
+		return "", "", fmt.Errorf("missing object key in %q", fileURL)
+	}
+	bucket, key = path[:i], path[i+1:]
+
+	if !bucketPattern.MatchString(bucket) || strings.Contains(bucket, "..") {
+		return "", "", fmt.Errorf("invalid bucket name %q", bucket)
+	}
+	if strings.Trim(key, "/") == "" {
+		return "", "", fmt.Errorf("missing object key in %q", fileURL)
+	}
+
+	return bucket, key, nil
+}
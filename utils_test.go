@@ -0,0 +1,42 @@
+package cloudinary
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestParseBucketURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		fileURL    string
+		scheme     string
+		pattern    *regexp.Regexp
+		wantBucket string
+		wantKey    string
+		wantErr    bool
+	}{
+		{"s3", "s3://my-bucket/path/to/image.jpg", s3Scheme, s3BucketPattern, "my-bucket", "path/to/image.jpg", false},
+		{"gs", "gs://my_bucket.example/image.jpg", gsScheme, gsBucketPattern, "my_bucket.example", "image.jpg", false},
+		{"upper case bucket", "s3://My-Bucket/image.jpg", s3Scheme, s3BucketPattern, "", "", true},
+		{"underscore in s3 bucket", "s3://my_bucket/image.jpg", s3Scheme, s3BucketPattern, "", "", true},
+		{"short bucket", "s3://ab/image.jpg", s3Scheme, s3BucketPattern, "", "", true},
+		{"bucket with ..", "s3://my..bucket/image.jpg", s3Scheme, s3BucketPattern, "", "", true},
+		{"bucket ending with -", "gs://my-bucket-/image.jpg", gsScheme, gsBucketPattern, "", "", true},
+		{"missing key", "s3://my-bucket", s3Scheme, s3BucketPattern, "", "", true},
+		{"empty key", "s3://my-bucket/", s3Scheme, s3BucketPattern, "", "", true},
+		{"slashes only key", "gs://my-bucket///", gsScheme, gsBucketPattern, "", "", true},
+		{"other scheme", "gs://my-bucket/image.jpg", s3Scheme, s3BucketPattern, "", "", true},
+		{"local path", "/tmp/logs3://x/image.jpg", s3Scheme, s3BucketPattern, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bucket, key, err := parseBucketURL(tt.fileURL, tt.scheme, tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseBucketURL() error = %v, want error %v", err, tt.wantErr)
+			}
+			if bucket != tt.wantBucket || key != tt.wantKey {
+				t.Errorf("parseBucketURL() = %q, %q, want %q, %q", bucket, key, tt.wantBucket, tt.wantKey)
+			}
+		})
+	}
+}